@@ -1,47 +1,299 @@
 package main
 
 import (
+	"context"
 	"net"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/synchthia/systera-api/database"
 	"github.com/synchthia/systera-api/logger"
+	"github.com/synchthia/systera-api/metrics"
 	"github.com/synchthia/systera-api/server"
 	"github.com/synchthia/systera-api/stream"
+	pb "github.com/synchthia/systera-api/systerapb"
 )
 
-func startGRPC(port string, mysql *database.Mysql) error {
+func startGRPC(port string, db database.Storage, stream *stream.Stream) error {
 	lis, err := net.Listen("tcp", port)
 	if err != nil {
 		return err
 	}
-	return server.NewGRPCServer(mysql).Serve(lis)
+	return server.NewGRPCServer(db, stream).Serve(lis)
+}
+
+// journalConfig - STREAM_JOURNAL_MAXLEN / STREAM_JOURNAL_MAXLEN_<TOPIC>
+func journalConfig() stream.JournalConfig {
+	config := stream.JournalConfig{
+		MaxLen:      10000,
+		TopicMaxLen: make(map[string]int64),
+	}
+
+	if n, err := strconv.ParseInt(os.Getenv("STREAM_JOURNAL_MAXLEN"), 10, 64); err == nil {
+		config.MaxLen = n
+	}
+	for _, topic := range pb.StreamTopic_name {
+		if n, err := strconv.ParseInt(os.Getenv("STREAM_JOURNAL_MAXLEN_"+topic), 10, 64); err == nil {
+			config.TopicMaxLen[strings.ToLower(topic)] = n
+		}
+	}
+	return config
+}
+
+// uuidResolver - UUID_RESOLVER / UUID_RESOLVER_TIMEOUT / UUID_CACHE_TTL / UUID_NEGATIVE_CACHE_TTL
+func uuidResolver() database.UUIDResolver {
+	duration := func(key string, def time.Duration) time.Duration {
+		if d, err := time.ParseDuration(os.Getenv(key)); err == nil {
+			return d
+		}
+		return def
+	}
+
+	switch os.Getenv("UUID_RESOLVER") {
+	case "offline":
+		return database.OfflineResolver{}
+	case "", "mojang":
+		return database.NewCachingResolver(
+			database.NewMojangResolver(duration("UUID_RESOLVER_TIMEOUT", 5*time.Second)),
+			duration("UUID_CACHE_TTL", time.Hour),
+			duration("UUID_NEGATIVE_CACHE_TTL", 5*time.Minute),
+		)
+	default:
+		logrus.Fatalf("[Resolver] Unknown UUID_RESOLVER: %s", os.Getenv("UUID_RESOLVER"))
+		return nil
+	}
+}
+
+// profileCache - PROFILE_CACHE_SIZE / PROFILE_CACHE_TTL / PROFILE_CACHE_REDIS / PROFILE_CACHE_REDIS_TTL
+func profileCache(redisAddr string) database.ProfileCache {
+	duration := func(key string, def time.Duration) time.Duration {
+		if d, err := time.ParseDuration(os.Getenv(key)); err == nil {
+			return d
+		}
+		return def
+	}
+
+	size := 10000
+	if n, err := strconv.Atoi(os.Getenv("PROFILE_CACHE_SIZE")); err == nil {
+		size = n
+	}
+	if size <= 0 {
+		return nil
+	}
+
+	// Local entries are not invalidated by other replicas, so keep TTL short
+	local := database.NewLRUProfileCache(size, duration("PROFILE_CACHE_TTL", 5*time.Second))
+	if len(os.Getenv("PROFILE_CACHE_REDIS")) == 0 {
+		return local
+	}
+	return database.NewTieredProfileCache(local, database.NewRedisProfileCache(redisAddr, duration("PROFILE_CACHE_REDIS_TTL", 10*time.Minute)))
+}
+
+// openDatabase - DATABASE_DRIVER (sqlite, otherwise selected by scheme of DATABASE_URL)
+func openDatabase() *database.Mysql {
+	switch os.Getenv("DATABASE_DRIVER") {
+	case "", "mysql", "postgres":
+		dsn := os.Getenv("DATABASE_URL")
+		if len(dsn) == 0 {
+			dsn = os.Getenv("MYSQL_CONNECTION_STRING")
+		}
+		if len(dsn) == 0 {
+			dsn = "root:docker@tcp(localhost:3306)/systera?charset=utf8mb4&parseTime=True&loc=Local"
+		}
+		if driver := os.Getenv("DATABASE_DRIVER"); len(driver) != 0 && driver != database.DriverOf(dsn) {
+			logrus.Fatalf("[Database] DATABASE_DRIVER is %s, but DATABASE_URL selects %s (use %s:// scheme)", driver, database.DriverOf(dsn), driver)
+		}
+		return database.NewClient(dsn)
+	case "sqlite":
+		path := os.Getenv("SQLITE_PATH")
+		if len(path) == 0 {
+			path = "systera.db"
+		}
+		return database.NewSQLiteClient(path)
+	default:
+		logrus.Fatalf("[Database] Unknown DATABASE_DRIVER: %s", os.Getenv("DATABASE_DRIVER"))
+		return nil
+	}
+}
+
+// objectStore - EVIDENCE_STORE / EVIDENCE_STORE_PATH (nil: evidence blobs are rejected)
+func objectStore() database.ObjectStore {
+	switch os.Getenv("EVIDENCE_STORE") {
+	case "":
+		return nil
+	case "local":
+		path := os.Getenv("EVIDENCE_STORE_PATH")
+		if len(path) == 0 {
+			path = "evidence"
+		}
+		store, err := database.NewLocalObjectStore(path)
+		if err != nil {
+			logrus.Fatalf("[Evidence] Failed to open object store: %s", err)
+		}
+		return store
+	case "memory":
+		logrus.Warnf("[Evidence] Using in-memory object store, blobs will be lost on exit")
+		return database.NewMemoryObjectStore()
+	default:
+		logrus.Fatalf("[Evidence] Unknown EVIDENCE_STORE: %s", os.Getenv("EVIDENCE_STORE"))
+		return nil
+	}
+}
+
+// openStorage - Open database and refuse to serve if schema is behind (DATABASE_AUTO_MIGRATE to migrate first)
+func openStorage() database.Storage {
+	if os.Getenv("DATABASE_DRIVER") == "memory" {
+		logrus.Warnf("[Database] Using in-memory storage, data will be lost on exit")
+		memory := database.NewMemory()
+		memory.SetUUIDResolver(uuidResolver())
+		memory.SetObjectStore(objectStore())
+		return memory
+	}
+
+	db := openDatabase()
+	if len(os.Getenv("DATABASE_AUTO_MIGRATE")) != 0 {
+		if _, err := db.MigrateUp(0); err != nil {
+			logrus.Fatalf("[Database] Failed to migrate: %s", err)
+		}
+	}
+	if err := db.CheckSchema(); err != nil {
+		logrus.Fatalf("[Database] %s", err)
+	}
+	db.SetUUIDResolver(uuidResolver())
+	db.SetObjectStore(objectStore())
+	return db
+}
+
+// isLoopback - Listen address is bound to loopback only
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
 }
 
 func main() {
 	// Init Logger
 	logger.Init()
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		migrate(os.Args[2:])
+		return
+	}
+
 	// Init
 	logrus.Printf("[API] Starting SYSTERA-API Server...")
 
-	// Redis
+	// Stream
+	redisAddr := os.Getenv("REDIS_ADDRESS")
+	if len(redisAddr) == 0 {
+		redisAddr = "localhost:6379"
+	}
+	natsURL := os.Getenv("NATS_URL")
+	if len(natsURL) == 0 {
+		natsURL = "nats://localhost:4222"
+	}
+	publisher, err := stream.NewPublisher(stream.Config{
+		Broker:       os.Getenv("STREAM_BROKER"),
+		RedisAddress: redisAddr,
+		NatsURL:      natsURL,
+	})
+	if err != nil {
+		logrus.Fatalf("[Stream] Failed to create publisher: %s", err)
+	}
+	var journal stream.Journal
+	if len(os.Getenv("STREAM_JOURNAL")) != 0 {
+		journal = stream.NewRedisJournal(redisAddr, journalConfig())
+	}
+	encodings, err := stream.ParseEncodings(os.Getenv("STREAM_ENCODINGS"))
+	if err != nil {
+		logrus.Fatalf("[Stream] Invalid STREAM_ENCODINGS: %s", err)
+	}
+	streamClient := stream.New(publisher, journal, encodings...)
+
+	// Connect to Database
+	db := openStorage()
+	if cache := profileCache(redisAddr); cache != nil {
+		cached := database.NewCachedStorage(db, cache)
+		metrics.RegisterProfileCache(func() (uint64, uint64) {
+			stats := cached.Stats()
+			return stats.Hits, stats.Misses
+		})
+		db = cached
+	}
+
+	// Metrics
+	metrics.RegisterOnlinePlayers(db.CountOnlinePlayers)
 	go func() {
-		redisAddr := os.Getenv("REDIS_ADDRESS")
-		if len(redisAddr) == 0 {
-			redisAddr = "localhost:6379"
+		port := os.Getenv("METRICS_LISTEN_PORT")
+		if len(port) == 0 {
+			port = ":17301"
+		}
+
+		if err := metrics.Serve(port); err != nil {
+			logrus.Fatalf("[Metrics] Metrics Error: %s", err)
 		}
-		stream.NewRedisPool(redisAddr)
 	}()
 
-	// Connect to MySQL
-	mysqlConStr := os.Getenv("MYSQL_CONNECTION_STRING")
-	if len(mysqlConStr) == 0 {
-		mysqlConStr = "root:docker@tcp(localhost:3306)/systera?charset=utf8mb4&parseTime=True&loc=Local"
+	// Kick message for banned players
+	if text := os.Getenv("KICK_MESSAGE_TEMPLATE"); len(text) != 0 {
+		if err := server.SetKickMessage(text); err != nil {
+			logrus.Fatalf("[API] Invalid KICK_MESSAGE_TEMPLATE: %s", err)
+		}
+	}
+
+	// Staff scope
+	if token := os.Getenv("STAFF_TOKEN"); len(token) != 0 {
+		server.SetStaffToken(token)
+	} else {
+		logrus.Warnf("[API] STAFF_TOKEN is not set, staff notes are disabled")
+	}
+
+	// Appeals
+	if d, err := time.ParseDuration(os.Getenv("APPEAL_COOLDOWN")); err == nil {
+		server.SetAppealCooldown(d)
+	}
+
+	// Announce Scheduler
+	interval, err := time.ParseDuration(os.Getenv("ANNOUNCE_SCHEDULER_INTERVAL"))
+	if err != nil || interval <= 0 {
+		interval = 5 * time.Second
 	}
-	mysqlClient := database.NewMysqlClient(mysqlConStr, "systera")
+	go server.NewAnnounceScheduler(db, streamClient).Run(context.Background(), interval)
+
+	// Sweeper
+	go server.NewSweeper(db, streamClient).Run(context.Background(), 5*time.Second)
+
+	// REST Gateway
+	go func() {
+		port := os.Getenv("GATEWAY_LISTEN_PORT")
+		if len(port) == 0 {
+			port = "127.0.0.1:17302"
+		}
+
+		if token := os.Getenv("GATEWAY_TOKEN"); len(token) != 0 {
+			server.SetGatewayToken(token)
+		} else if !isLoopback(port) {
+			logrus.Fatalf("[Gateway] GATEWAY_TOKEN is required when listening on %s", port)
+		}
+
+		msg := logrus.WithField("listen", port)
+		msg.Infof("[Gateway] Listening %s", port)
+
+		if err := http.ListenAndServe(port, server.NewHTTPGateway(db, streamClient)); err != nil {
+			logrus.Fatalf("[Gateway] Gateway Error: %s", err)
+		}
+	}()
 
 	// gRPC
 	wait := make(chan struct{})
@@ -55,7 +307,7 @@ func main() {
 		msg := logrus.WithField("listen", port)
 		msg.Infof("[GRPC] Listening %s", port)
 
-		if err := startGRPC(port, mysqlClient); err != nil {
+		if err := startGRPC(port, db, streamClient); err != nil {
 			logrus.Fatalf("[GRPC] gRPC Error: %s", err)
 		}
 	}()
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/synchthia/systera-api/systerapb"
+)
+
+func (c *cli) appeals(args []string) error {
+	sub, args := subcommand(args)
+	switch sub {
+	case "list":
+		return c.appealList(args)
+	case "accept":
+		return c.appealReview(args, true)
+	case "deny":
+		return c.appealReview(args, false)
+	default:
+		return errors.New("usage: appeals <list|accept|deny> ...")
+	}
+}
+
+func (c *cli) appealList(args []string) error {
+	fs := flag.NewFlagSet("appeals list", flag.ContinueOnError)
+	state := fs.String("state", "OPEN", "State (OPEN, ACCEPTED, DENIED, ANY)")
+	limit := fs.Int("limit", 50, "Max entries")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return errors.New("usage: appeals list [player] [-state STATE] [-limit N]")
+	}
+
+	filterState, ok := pb.AppealState_value["APPEAL_"+strings.ToUpper(*state)]
+	if !ok {
+		return fmt.Errorf("unknown state: %s", *state)
+	}
+
+	req := &pb.ListAppealsRequest{
+		State: pb.AppealState(filterState),
+		Limit: int32(*limit),
+	}
+	if len(positional) == 1 {
+		target, err := c.resolve(positional[0])
+		if err != nil {
+			return err
+		}
+		req.Uuid = target.Uuid
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.ListAppeals(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	c.out.Print(r, func() ([]string, [][]string) {
+		var rows [][]string
+		for _, e := range r.Entries {
+			rows = append(rows, []string{
+				strconv.FormatUint(uint64(e.Id), 10),
+				formatTime(e.Date),
+				strings.TrimPrefix(e.State.String(), "APPEAL_"),
+				e.From.GetName(),
+				e.Punishment.GetLevel().String(),
+				e.Punishment.GetReason(),
+				e.Message,
+				e.Reviewer.GetName(),
+			})
+		}
+		return []string{"ID", "DATE", "STATE", "PLAYER", "LEVEL", "REASON", "MESSAGE", "REVIEWER"}, rows
+	})
+	return nil
+}
+
+func (c *cli) appealReview(args []string, accept bool) error {
+	verb := "deny"
+	if accept {
+		verb = "accept"
+	}
+
+	fs := flag.NewFlagSet("appeals "+verb, flag.ContinueOnError)
+	note := fs.String("note", "", "Review note")
+	by := fs.String("by", "CONSOLE", "Reviewer name")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("usage: appeals %s <id> [-note N] [-by NAME]", verb)
+	}
+
+	id, err := strconv.ParseUint(positional[0], 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid appeal id: %s", positional[0])
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.ReviewAppeal(ctx, &pb.ReviewAppealRequest{
+		Id:       uint32(id),
+		Reviewer: &pb.PlayerIdentity{Name: *by},
+		Accept:   accept,
+		Note:     *note,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.done(r, fmt.Sprintf("appeal %d: %s", id, strings.TrimPrefix(r.Entry.GetState().String(), "APPEAL_")))
+	return nil
+}
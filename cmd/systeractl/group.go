@@ -0,0 +1,156 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"sort"
+	"strings"
+
+	pb "github.com/synchthia/systera-api/systerapb"
+)
+
+func (c *cli) group(args []string) error {
+	sub, args := subcommand(args)
+	switch sub {
+	case "list":
+		return c.groupList()
+	case "create":
+		return c.groupCreate(args)
+	case "remove":
+		return c.groupRemove(args)
+	case "perm":
+		return c.groupPerm(args)
+	case "set":
+		return c.groupSet(args)
+	default:
+		return errors.New("usage: group <list|create|remove|perm|set> ...")
+	}
+}
+
+func (c *cli) groupList() error {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.FetchGroups(ctx, &pb.FetchGroupsRequest{})
+	if err != nil {
+		return err
+	}
+
+	c.out.Print(r, func() ([]string, [][]string) {
+		var rows [][]string
+		for _, g := range r.Groups {
+			perms := g.Permissions
+			sort.Slice(perms, func(i, j int) bool { return perms[i].ServerName < perms[j].ServerName })
+			if len(perms) == 0 {
+				rows = append(rows, []string{g.GroupName, g.GroupPrefix, "-", "-"})
+			}
+			for _, p := range perms {
+				rows = append(rows, []string{g.GroupName, g.GroupPrefix, p.ServerName, strings.Join(p.Permissions, ",")})
+			}
+		}
+		return []string{"GROUP", "PREFIX", "SERVER", "PERMISSIONS"}, rows
+	})
+	return nil
+}
+
+func (c *cli) groupCreate(args []string) error {
+	fs := flag.NewFlagSet("group create", flag.ContinueOnError)
+	prefix := fs.String("prefix", "", "Chat prefix")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: group create <group> [-prefix P]")
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.CreateGroup(ctx, &pb.CreateGroupRequest{
+		GroupEntry: &pb.GroupEntry{
+			GroupName:   positional[0],
+			GroupPrefix: *prefix,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	c.done(r, "created "+positional[0])
+	return nil
+}
+
+func (c *cli) groupRemove(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: group remove <group>")
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.RemoveGroup(ctx, &pb.RemoveGroupRequest{GroupName: args[0]})
+	if err != nil {
+		return err
+	}
+
+	c.done(r, "removed "+args[0])
+	return nil
+}
+
+func (c *cli) groupPerm(args []string) error {
+	sub, args := subcommand(args)
+	if (sub != "add" && sub != "remove") || len(args) < 3 {
+		return errors.New("usage: group perm <add|remove> <group> <server> <perm>...")
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	var r *pb.Empty
+	var err error
+	if sub == "add" {
+		r, err = c.client.AddPermission(ctx, &pb.AddPermissionRequest{
+			GroupName:   args[0],
+			Target:      args[1],
+			Permissions: args[2:],
+		})
+	} else {
+		r, err = c.client.RemovePermission(ctx, &pb.RemovePermissionRequest{
+			GroupName:   args[0],
+			Target:      args[1],
+			Permissions: args[2:],
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	c.done(r, sub+" "+strings.Join(args[2:], ","))
+	return nil
+}
+
+func (c *cli) groupSet(args []string) error {
+	if len(args) < 2 {
+		return errors.New("usage: group set <player> <group>...")
+	}
+
+	target, err := c.resolve(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.SetPlayerGroups(ctx, &pb.SetPlayerGroupsRequest{
+		Uuid:   target.Uuid,
+		Groups: args[1:],
+	})
+	if err != nil {
+		return err
+	}
+
+	c.done(r, "groups set: "+strings.Join(args[1:], ","))
+	return nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	pb "github.com/synchthia/systera-api/systerapb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const usage = `systeractl - Systera admin client
+
+Usage:
+  systeractl [flags] <command> [args]
+
+Commands:
+  player <name|uuid>                              Lookup player profile
+  punish list <player> [-all] [-level LEVEL]      List punishments
+  punish search [-since 7d] [-by NAME] [-active]  Search punishments of all players (-level, -reason, -cursor)
+  punish ban <player> -reason R [-duration 7d]    Ban player (TEMPBAN with duration, PERMBAN otherwise)
+  punish unban <player>                           Revoke active ban
+  notes <player> [-all]                           List staff notes
+  notes add <player> <message>... [-severity S]   Add staff note (-expires 30d)
+  notes delete <id>                               Delete staff note
+  appeals list [player] [-state OPEN]             List punishment appeals
+  appeals accept|deny <id> [-note N]              Review appeal (accept revokes punishment)
+  group list                                      List groups
+  group create <group> [-prefix P]                Create group
+  group remove <group>                            Remove group
+  group perm add <group> <server> <perm>...       Add permissions
+  group perm remove <group> <server> <perm>...    Remove permissions
+  group set <player> <group>...                   Set player groups
+  announce <target> <message>...                  Broadcast message
+  dispatch <target> <command>... [-wait 5s]       Dispatch command (target: server, group or pattern)
+  servers [-all]                                  List registered servers
+  reports [player] [-limit N]                     View reports
+
+Flags:
+`
+
+// cli - Shared client state
+type cli struct {
+	client  pb.SysteraClient
+	out     printer
+	timeout time.Duration
+}
+
+func main() {
+	addr := os.Getenv("SYSTERA_ADDRESS")
+	if len(addr) == 0 {
+		addr = "localhost:17300"
+	}
+
+	flag.StringVar(&addr, "addr", addr, "Systera API address (env: SYSTERA_ADDRESS)")
+	token := flag.String("token", os.Getenv("SYSTERA_TOKEN"), "Staff token (env: SYSTERA_TOKEN)")
+	output := flag.String("o", "table", "Output format (table, json)")
+	timeout := flag.Duration("timeout", 10*time.Second, "Request timeout")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	out, err := newPrinter(*output)
+	if err != nil {
+		fatal(err)
+	}
+
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(withToken(*token)),
+	)
+	if err != nil {
+		fatal(err)
+	}
+	defer conn.Close()
+
+	c := &cli{
+		client:  pb.NewSysteraClient(conn),
+		out:     out,
+		timeout: *timeout,
+	}
+
+	args := flag.Args()
+	switch args[0] {
+	case "player":
+		err = c.player(args[1:])
+	case "punish":
+		err = c.punish(args[1:])
+	case "notes":
+		err = c.notes(args[1:])
+	case "appeals":
+		err = c.appeals(args[1:])
+	case "group":
+		err = c.group(args[1:])
+	case "announce":
+		err = c.announce(args[1:])
+	case "dispatch":
+		err = c.dispatch(args[1:])
+	case "servers":
+		err = c.servers(args[1:])
+	case "reports":
+		err = c.reports(args[1:])
+	default:
+		err = fmt.Errorf("unknown command: %s", args[0])
+	}
+
+	if err != nil {
+		fatal(err)
+	}
+}
+
+// withToken - Send staff token as authorization metadata
+func withToken(token string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func (c *cli) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), c.timeout)
+}
+
+// subcommand - Pop subcommand name from args
+func subcommand(args []string) (string, []string) {
+	if len(args) == 0 {
+		return "", nil
+	}
+	return args[0], args[1:]
+}
+
+// parseFlags - Parse flags after positional arguments (ex. "ban Steve -reason hack")
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "systeractl: %s\n", err)
+	os.Exit(1)
+}
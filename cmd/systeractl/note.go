@@ -0,0 +1,138 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/synchthia/systera-api/systerapb"
+)
+
+func (c *cli) notes(args []string) error {
+	switch sub, rest := subcommand(args); sub {
+	case "add":
+		return c.noteAdd(rest)
+	case "delete":
+		return c.noteDelete(rest)
+	default:
+		return c.noteList(args)
+	}
+}
+
+func (c *cli) noteList(args []string) error {
+	fs := flag.NewFlagSet("notes", flag.ContinueOnError)
+	all := fs.Bool("all", false, "Include expired notes")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: notes <player> [-all]")
+	}
+
+	target, err := c.resolve(positional[0])
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.ListPlayerNotes(ctx, &pb.ListPlayerNotesRequest{
+		Uuid:           target.Uuid,
+		IncludeExpired: *all,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.out.Print(r, func() ([]string, [][]string) {
+		var rows [][]string
+		for _, e := range r.Entries {
+			rows = append(rows, []string{
+				strconv.FormatUint(uint64(e.Id), 10),
+				formatTime(e.CreatedAt),
+				strings.TrimPrefix(e.Severity.String(), "NOTE_"),
+				e.Author.GetName(),
+				e.Message,
+				formatTime(e.ExpiresAt),
+			})
+		}
+		return []string{"ID", "DATE", "SEVERITY", "AUTHOR", "MESSAGE", "EXPIRE"}, rows
+	})
+	return nil
+}
+
+func (c *cli) noteAdd(args []string) error {
+	fs := flag.NewFlagSet("notes add", flag.ContinueOnError)
+	severity := fs.String("severity", "INFO", "Severity (INFO, WATCH, ALERT)")
+	expires := fs.String("expires", "", "Expire after duration (ex. 30d); never if empty")
+	by := fs.String("by", "CONSOLE", "Author name")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) < 2 {
+		return errors.New("usage: notes add <player> <message>... [-severity S] [-expires 30d] [-by NAME]")
+	}
+
+	level, ok := pb.NoteSeverity_value["NOTE_"+strings.ToUpper(*severity)]
+	if !ok {
+		return fmt.Errorf("unknown severity: %s", *severity)
+	}
+
+	target, err := c.resolve(positional[0])
+	if err != nil {
+		return err
+	}
+
+	entry := &pb.PlayerNote{
+		Uuid:     target.Uuid,
+		Message:  strings.Join(positional[1:], " "),
+		Severity: pb.NoteSeverity(level),
+		Author:   &pb.PlayerIdentity{Name: *by},
+	}
+	if *expires != "" {
+		d, err := parseDuration(*expires)
+		if err != nil {
+			return err
+		}
+		entry.ExpiresAt = time.Now().Add(d).UnixMilli()
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.AddPlayerNote(ctx, &pb.AddPlayerNoteRequest{Entry: entry})
+	if err != nil {
+		return err
+	}
+
+	c.done(r, fmt.Sprintf("added note %d to %s", r.Entry.GetId(), positional[0]))
+	return nil
+}
+
+func (c *cli) noteDelete(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: notes delete <id>")
+	}
+
+	id, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid note id: %s", args[0])
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.DeletePlayerNote(ctx, &pb.DeletePlayerNoteRequest{Id: uint32(id)})
+	if err != nil {
+		return err
+	}
+
+	c.done(r, "deleted note "+args[0])
+	return nil
+}
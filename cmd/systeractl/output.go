@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// printer - Print response as table or JSON
+type printer interface {
+	// Print - Print message; table() is used for table output
+	Print(m proto.Message, table func() ([]string, [][]string))
+}
+
+func newPrinter(format string) (printer, error) {
+	switch format {
+	case "table":
+		return &tablePrinter{}, nil
+	case "json":
+		return &jsonPrinter{}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format: %s", format)
+	}
+}
+
+type tablePrinter struct{}
+
+func (p *tablePrinter) Print(m proto.Message, table func() ([]string, [][]string)) {
+	header, rows := table()
+	if len(rows) == 0 {
+		fmt.Println("(no entries)")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	w.Flush()
+}
+
+type jsonPrinter struct{}
+
+func (p *jsonPrinter) Print(m proto.Message, _ func() ([]string, [][]string)) {
+	b, err := protojson.MarshalOptions{
+		Multiline:       true,
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}.Marshal(m)
+	if err != nil {
+		fatal(err)
+	}
+	fmt.Println(string(b))
+}
+
+// formatTime - Format unix millis (0: "-")
+func formatTime(millis int64) string {
+	if millis <= 0 {
+		return "-"
+	}
+	return time.UnixMilli(millis).Format("2006/01/02 15:04:05")
+}
+
+// done - Print result for calls without response body
+func (c *cli) done(m proto.Message, msg string) {
+	c.out.Print(m, func() ([]string, [][]string) {
+		return []string{"RESULT"}, [][]string{{msg}}
+	})
+}
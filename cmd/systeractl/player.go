@@ -0,0 +1,63 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/synchthia/systera-api/systerapb"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+
+// resolve - Resolve player name or UUID to identity
+func (c *cli) resolve(player string) (*pb.PlayerIdentity, error) {
+	if uuidPattern.MatchString(player) {
+		return &pb.PlayerIdentity{Uuid: player}, nil
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.GetPlayerIdentityByName(ctx, &pb.GetPlayerIdentityByNameRequest{Name: player})
+	if err != nil {
+		return nil, fmt.Errorf("lookup %s: %w", player, err)
+	}
+	return r.Identity, nil
+}
+
+func (c *cli) player(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: player <name|uuid>")
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	var r *pb.FetchPlayerProfileResponse
+	var err error
+	if uuidPattern.MatchString(args[0]) {
+		r, err = c.client.FetchPlayerProfile(ctx, &pb.FetchPlayerProfileRequest{Uuid: args[0]})
+	} else {
+		r, err = c.client.FetchPlayerProfileByName(ctx, &pb.FetchPlayerProfileByNameRequest{Name: args[0]})
+	}
+	if err != nil {
+		return err
+	}
+
+	c.out.Print(r, func() ([]string, [][]string) {
+		e := r.Entry
+		return []string{"FIELD", "VALUE"}, [][]string{
+			{"UUID", e.Uuid},
+			{"Name", e.Name},
+			{"Server", e.CurrentServer},
+			{"Groups", strings.Join(e.Groups, ",")},
+			{"First Login", formatTime(e.FirstLogin)},
+			{"Last Login", formatTime(e.LastLogin)},
+			{"Staff Notes", strconv.Itoa(int(r.StaffNoteCount))},
+		}
+	})
+	return nil
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/synchthia/systera-api/systerapb"
+)
+
+func (c *cli) punish(args []string) error {
+	sub, args := subcommand(args)
+	switch sub {
+	case "list":
+		return c.punishList(args)
+	case "ban":
+		return c.punishBan(args)
+	case "unban":
+		return c.punishUnban(args)
+	case "search":
+		return c.punishSearch(args)
+	default:
+		return errors.New("usage: punish <list|search|ban|unban> ...")
+	}
+}
+
+func (c *cli) punishList(args []string) error {
+	fs := flag.NewFlagSet("punish list", flag.ContinueOnError)
+	all := fs.Bool("all", false, "Include expired / revoked punishments")
+	level := fs.String("level", "WARN", "Minimum level (WARN, KICK, TEMPBAN, PERMBAN)")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: punish list <player> [-all] [-level LEVEL]")
+	}
+
+	filterLevel, ok := pb.PunishLevel_value[strings.ToUpper(*level)]
+	if !ok {
+		return fmt.Errorf("unknown level: %s", *level)
+	}
+
+	target, err := c.resolve(positional[0])
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.GetPlayerPunish(ctx, &pb.GetPlayerPunishRequest{
+		Uuid:           target.Uuid,
+		FilterLevel:    pb.PunishLevel(filterLevel),
+		IncludeExpired: *all,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.out.Print(r, func() ([]string, [][]string) {
+		var rows [][]string
+		for _, e := range r.Entry {
+			rows = append(rows, []string{
+				strconv.FormatUint(uint64(e.Id), 10),
+				formatTime(e.Date),
+				e.Level.String(),
+				e.PunishedTo.GetName(),
+				e.PunishedFrom.GetName(),
+				e.Reason,
+				formatTime(e.Expire),
+				strconv.FormatBool(e.Available),
+			})
+		}
+		return []string{"ID", "DATE", "LEVEL", "TARGET", "PUNISHER", "REASON", "EXPIRE", "ACTIVE"}, rows
+	})
+	return nil
+}
+
+func (c *cli) punishSearch(args []string) error {
+	fs := flag.NewFlagSet("punish search", flag.ContinueOnError)
+	since := fs.String("since", "", "Punished within duration (ex. 7d, 12h)")
+	by := fs.String("by", "", "Punisher name or UUID")
+	level := fs.String("level", "", "Level (WARN, KICK, TEMPBAN, PERMBAN)")
+	reason := fs.String("reason", "", "Reason keyword")
+	active := fs.Bool("active", false, "Only active punishments")
+	limit := fs.Int("limit", 50, "Max entries per page")
+	cursor := fs.String("cursor", "", "Cursor of next page")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return errors.New("usage: punish search [-since 7d] [-by NAME] [-level LEVEL] [-reason R] [-active] [-limit N] [-cursor C]")
+	}
+
+	req := &pb.SearchPunishmentsRequest{
+		Punisher:   *by,
+		Keyword:    *reason,
+		ActiveOnly: *active,
+		Cursor:     *cursor,
+		Limit:      int32(*limit),
+	}
+	if *since != "" {
+		d, err := parseDuration(*since)
+		if err != nil {
+			return err
+		}
+		req.From = time.Now().Add(-d).UnixMilli()
+	}
+	if *level != "" {
+		l, ok := pb.PunishLevel_value[strings.ToUpper(*level)]
+		if !ok {
+			return fmt.Errorf("unknown level: %s", *level)
+		}
+		req.Level = pb.PunishLevel(l)
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.SearchPunishments(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	c.out.Print(r, func() ([]string, [][]string) {
+		var rows [][]string
+		for _, e := range r.Entries {
+			rows = append(rows, []string{
+				strconv.FormatUint(uint64(e.Id), 10),
+				formatTime(e.Date),
+				e.Level.String(),
+				e.PunishedTo.GetName(),
+				e.PunishedFrom.GetName(),
+				e.Reason,
+				strconv.FormatBool(e.Available),
+			})
+		}
+		return []string{"ID", "DATE", "LEVEL", "TARGET", "PUNISHER", "REASON", "ACTIVE"}, rows
+	})
+	if _, ok := c.out.(*tablePrinter); ok {
+		var totals []string
+		for _, t := range r.Totals {
+			totals = append(totals, fmt.Sprintf("%s=%d", t.Level, t.Count))
+		}
+		fmt.Printf("\nTotals: %s\n", strings.Join(totals, " "))
+		if r.NextCursor != "" {
+			fmt.Printf("Next page: -cursor %s\n", r.NextCursor)
+		}
+	}
+	return nil
+}
+
+func (c *cli) punishBan(args []string) error {
+	fs := flag.NewFlagSet("punish ban", flag.ContinueOnError)
+	reason := fs.String("reason", "", "Reason (required)")
+	duration := fs.String("duration", "", "Ban duration (ex. 30m, 12h, 7d); permanent if empty")
+	by := fs.String("by", "CONSOLE", "Punisher name")
+	force := fs.Bool("force", false, "Punish even if the player never joined")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 || *reason == "" {
+		return errors.New("usage: punish ban <player> -reason R [-duration 7d] [-by NAME] [-force]")
+	}
+
+	now := time.Now()
+	entry := &pb.PunishEntry{
+		Available:    true,
+		Level:        pb.PunishLevel_PERMBAN,
+		Reason:       *reason,
+		Date:         now.UnixMilli(),
+		PunishedFrom: &pb.PlayerIdentity{Name: *by},
+		PunishedTo:   &pb.PlayerIdentity{Name: positional[0]},
+	}
+	if *duration != "" {
+		d, err := parseDuration(*duration)
+		if err != nil {
+			return err
+		}
+		entry.Level = pb.PunishLevel_TEMPBAN
+		entry.Expire = now.Add(d).UnixMilli()
+	}
+	if uuidPattern.MatchString(positional[0]) {
+		entry.PunishedTo = &pb.PlayerIdentity{Uuid: positional[0]}
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.SetPlayerPunish(ctx, &pb.SetPlayerPunishRequest{
+		Remote: true,
+		Force:  *force,
+		Entry:  entry,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.out.Print(r, func() ([]string, [][]string) {
+		return []string{"NO_PROFILE", "OFFLINE", "DUPLICATE", "COOLDOWN"}, [][]string{{
+			strconv.FormatBool(r.NoProfile),
+			strconv.FormatBool(r.Offline),
+			strconv.FormatBool(r.Duplicate),
+			strconv.FormatBool(r.Cooldown),
+		}}
+	})
+	return nil
+}
+
+func (c *cli) punishUnban(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: punish unban <player>")
+	}
+
+	target := &pb.PlayerIdentity{Name: args[0]}
+	if uuidPattern.MatchString(args[0]) {
+		target = &pb.PlayerIdentity{Uuid: args[0]}
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.UnBan(ctx, &pb.UnBanRequest{Target: target})
+	if err != nil {
+		return err
+	}
+
+	c.done(r, "unbanned "+args[0])
+	return nil
+}
+
+// parseDuration - time.ParseDuration with day ("d") unit
+func parseDuration(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %s", s)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
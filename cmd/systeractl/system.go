@@ -0,0 +1,154 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/synchthia/systera-api/systerapb"
+)
+
+func (c *cli) announce(args []string) error {
+	if len(args) < 2 {
+		return errors.New("usage: announce <target> <message>...")
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.Announce(ctx, &pb.AnnounceRequest{
+		Target:  args[0],
+		Message: strings.Join(args[1:], " "),
+	})
+	if err != nil {
+		return err
+	}
+
+	c.done(r, "announced to "+strings.Join(r.Targets, ", "))
+	return nil
+}
+
+func (c *cli) dispatch(args []string) error {
+	fs := flag.NewFlagSet("dispatch", flag.ContinueOnError)
+	wait := fs.Duration("wait", 0, "Wait for results from servers (ex. 5s)")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) < 2 {
+		return errors.New("usage: dispatch <target> <command>... [-wait 5s]")
+	}
+
+	// Request timeout applies after waiting for results
+	c.timeout += *wait
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.Dispatch(ctx, &pb.DispatchRequest{
+		Target:      positional[0],
+		Cmd:         strings.Join(positional[1:], " "),
+		WaitTimeout: wait.Milliseconds(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if *wait <= 0 {
+		c.done(r, "dispatched to "+strings.Join(r.Targets, ", ")+" ("+r.Id+")")
+		return nil
+	}
+
+	c.out.Print(r, func() ([]string, [][]string) {
+		var rows [][]string
+		for _, e := range r.Results {
+			rows = append(rows, []string{
+				e.ServerName,
+				strconv.FormatBool(e.Success),
+				e.Output,
+			})
+		}
+		return []string{"SERVER", "SUCCESS", "OUTPUT"}, rows
+	})
+	return nil
+}
+
+func (c *cli) servers(args []string) error {
+	fs := flag.NewFlagSet("servers", flag.ContinueOnError)
+	all := fs.Bool("all", false, "Include offline servers")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.ListServers(ctx, &pb.ListServersRequest{IncludeOffline: *all})
+	if err != nil {
+		return err
+	}
+
+	c.out.Print(r, func() ([]string, [][]string) {
+		var rows [][]string
+		for _, e := range r.Entries {
+			state := e.State
+			if !e.Online {
+				state = "OFFLINE"
+			}
+			rows = append(rows, []string{
+				e.Name,
+				e.Address,
+				fmt.Sprintf("%d/%d", e.Players, e.MaxPlayers),
+				state,
+				formatTime(e.LastHeartbeat),
+			})
+		}
+		return []string{"NAME", "ADDRESS", "PLAYERS", "STATE", "LAST HEARTBEAT"}, rows
+	})
+	return nil
+}
+
+func (c *cli) reports(args []string) error {
+	fs := flag.NewFlagSet("reports", flag.ContinueOnError)
+	limit := fs.Int("limit", 20, "Max entries")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return errors.New("usage: reports [player] [-limit N]")
+	}
+
+	req := &pb.GetReportsRequest{Limit: int32(*limit)}
+	if len(positional) == 1 {
+		target, err := c.resolve(positional[0])
+		if err != nil {
+			return err
+		}
+		req.Uuid = target.Uuid
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	r, err := c.client.GetReports(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	c.out.Print(r, func() ([]string, [][]string) {
+		var rows [][]string
+		for _, e := range r.Entry {
+			rows = append(rows, []string{
+				formatTime(e.Date),
+				e.Server,
+				e.From.GetName(),
+				e.To.GetName(),
+				e.Message,
+			})
+		}
+		return []string{"DATE", "SERVER", "FROM", "TO", "MESSAGE"}, rows
+	})
+	return nil
+}
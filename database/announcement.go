@@ -0,0 +1,153 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/status"
+	"github.com/synchthia/systera-api/systerapb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ScheduledAnnouncements - Scheduled (one-shot / recurring) announcement
+type ScheduledAnnouncements struct {
+	ID         uint       `gorm:"primary_key;AutoIncrement;"`
+	Target     string     // server name or pattern
+	Cron       string     // empty: one-shot
+	NextRun    *time.Time `gorm:"type:datetime;index;"` // nil: finished
+	LastRun    *time.Time `gorm:"type:datetime"`
+	Rotation   int        // next message index
+	AuthorUUID string
+	AuthorName string
+	CreatedAt  time.Time                       `gorm:"type:datetime"`
+	Messages   []ScheduledAnnouncementMessages `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// ScheduledAnnouncementMessages - Message pool of scheduled announcement
+type ScheduledAnnouncementMessages struct {
+	ID                       uint `gorm:"primary_key;AutoIncrement;"`
+	ScheduledAnnouncementsID uint `gorm:"foreign_key;index;"` // foreignKey
+	Position                 int
+	Message                  string `gorm:"type:text"`
+}
+
+// ToProtobuf - Convert to Protobuf
+func (a *ScheduledAnnouncements) ToProtobuf() *systerapb.ScheduledAnnouncement {
+	var messages []string
+	for _, m := range a.Messages {
+		messages = append(messages, m.Message)
+	}
+
+	entry := &systerapb.ScheduledAnnouncement{
+		Id:       uint32(a.ID),
+		Target:   a.Target,
+		Messages: messages,
+		Cron:     a.Cron,
+		Author: &systerapb.PlayerIdentity{
+			Uuid: a.AuthorUUID,
+			Name: a.AuthorName,
+		},
+		CreatedAt: a.CreatedAt.UnixMilli(),
+	}
+	if a.NextRun != nil {
+		entry.NextRun = a.NextRun.UnixMilli()
+	}
+	if a.LastRun != nil {
+		entry.LastRun = a.LastRun.UnixMilli()
+	}
+	return entry
+}
+
+// Message - Current message of pool
+func (a *ScheduledAnnouncements) Message() string {
+	if len(a.Messages) == 0 {
+		return ""
+	}
+	return a.Messages[a.Rotation%len(a.Messages)].Message
+}
+
+func preloadMessages(db *gorm.DB) *gorm.DB {
+	return db.Order("position")
+}
+
+// CreateScheduledAnnouncement - Create scheduled announcement
+func (s *Mysql) CreateScheduledAnnouncement(a *ScheduledAnnouncements) error {
+	for i := range a.Messages {
+		a.Messages[i].Position = i
+	}
+
+	if r := s.client.Create(a); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Announcement] Failed CreateScheduledAnnouncement")
+		return r.Error
+	}
+	return nil
+}
+
+// GetScheduledAnnouncements - Get scheduled announcements (ordered by next run)
+func (s *Mysql) GetScheduledAnnouncements(includeFinished bool) ([]ScheduledAnnouncements, error) {
+	var entries []ScheduledAnnouncements
+
+	q := s.client.Model(&ScheduledAnnouncements{}).Preload("Messages", preloadMessages).Order("next_run IS NULL, next_run, id")
+	if !includeFinished {
+		q = q.Where("next_run IS NOT NULL")
+	}
+
+	if r := q.Find(&entries); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Announcement] Failed GetScheduledAnnouncements")
+		return nil, r.Error
+	}
+	return entries, nil
+}
+
+// DeleteScheduledAnnouncement - Delete scheduled announcement
+func (s *Mysql) DeleteScheduledAnnouncement(id uint) error {
+	r := s.client.Select("Messages").Delete(&ScheduledAnnouncements{ID: id})
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Announcement] Failed DeleteScheduledAnnouncement (%d)", id)
+		return r.Error
+	}
+	if r.RowsAffected == 0 {
+		return status.ErrAnnouncementNotFound.Error
+	}
+	return nil
+}
+
+// ClaimDueAnnouncements - Reschedule announcements due at now and return them for publishing
+// Due rows are locked with SKIP LOCKED, so each run is claimed by only one replica.
+// next returns the next run (nil: finished). The new schedule is committed before the
+// caller publishes, so a failed reschedule never re-sends an announcement.
+// Returned entries keep the rotation of this run.
+func (s *Mysql) ClaimDueAnnouncements(now time.Time, limit int, next func(a *ScheduledAnnouncements) *time.Time) ([]ScheduledAnnouncements, error) {
+	var due []ScheduledAnnouncements
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		r := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Preload("Messages", preloadMessages).
+			Where("next_run <= ?", now).
+			Order("next_run").
+			Limit(limit).
+			Find(&due)
+		if r.Error != nil {
+			logrus.WithError(r.Error).Errorf("[Announcement] Failed get due announcements")
+			return r.Error
+		}
+
+		for i := range due {
+			a := &due[i]
+			r := tx.Model(&ScheduledAnnouncements{ID: a.ID}).Updates(map[string]interface{}{
+				"next_run": next(a),
+				"last_run": now,
+				"rotation": a.Rotation + 1,
+			})
+			if r.Error != nil {
+				logrus.WithError(r.Error).Errorf("[Announcement] Failed reschedule (%d)", a.ID)
+				return r.Error
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return due, nil
+}
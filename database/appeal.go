@@ -0,0 +1,222 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/status"
+	"github.com/synchthia/systera-api/systerapb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AppealState - State of punishment appeal
+type AppealState int32
+
+const (
+	// AppealAny - Any state (filter only)
+	AppealAny AppealState = iota
+
+	// AppealOpen - Waiting for review
+	AppealOpen
+
+	// AppealAccepted - Accepted (punishment is revoked)
+	AppealAccepted
+
+	// AppealDenied - Denied
+	AppealDenied
+)
+
+// PunishmentAppeals - Appeal of punishment by punished player
+type PunishmentAppeals struct {
+	ID            uint        `gorm:"primary_key;AutoIncrement;"`
+	PunishmentsID uint        `gorm:"index;"`
+	Punishment    Punishments `gorm:"foreignKey:PunishmentsID;"`
+	State         AppealState `gorm:"type:tinyint;index;"`
+	Message       string      `gorm:"type:text"`
+	PlayerUUID    string      `gorm:"index;"`
+	PlayerName    string
+	Date          time.Time `gorm:"type:datetime"`
+	ReviewerUUID  string
+	ReviewerName  string
+	ReviewNote    string     `gorm:"type:text"`
+	ReviewedAt    *time.Time `gorm:"type:datetime"`
+}
+
+// ToProtobuf - Convert to Protobuf
+func (a *PunishmentAppeals) ToProtobuf() *systerapb.AppealEntry {
+	entry := &systerapb.AppealEntry{
+		Id:         uint32(a.ID),
+		State:      a.State.ToProtobuf(),
+		Punishment: a.Punishment.ToProtobuf(),
+		From: &systerapb.PlayerIdentity{
+			Uuid: a.PlayerUUID,
+			Name: a.PlayerName,
+		},
+		Message: a.Message,
+		Date:    a.Date.UnixMilli(),
+		Reviewer: &systerapb.PlayerIdentity{
+			Uuid: a.ReviewerUUID,
+			Name: a.ReviewerName,
+		},
+		ReviewNote: a.ReviewNote,
+	}
+	if a.ReviewedAt != nil {
+		entry.ReviewedAt = a.ReviewedAt.UnixMilli()
+	}
+	return entry
+}
+
+func (i AppealState) String() string {
+	switch i {
+	case AppealOpen:
+		return "OPEN"
+	case AppealAccepted:
+		return "ACCEPTED"
+	case AppealDenied:
+		return "DENIED"
+	default:
+		return "ANY"
+	}
+}
+
+// ToProtobuf - Convert to Protobuf
+func (i AppealState) ToProtobuf() systerapb.AppealState {
+	return systerapb.AppealState(i)
+}
+
+// SubmitAppeal - Appeal active punishment (one open appeal per punishment, cooldown after review)
+func (s *Mysql) SubmitAppeal(punishmentID uint, from PlayerIdentity, message string, cooldown time.Duration) (*PunishmentAppeals, error) {
+	now := time.Now()
+	var appeal PunishmentAppeals
+
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		// Concurrent appeals of same punishment wait here
+		var punishment Punishments
+		r := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Limit(1).Find(&punishment, "id = ?", punishmentID)
+		if r.Error != nil {
+			return r.Error
+		}
+		if r.RowsAffected == 0 {
+			return status.ErrPunishmentNotFound.Error
+		}
+		if punishment.TargetPlayerUUID != from.UUID || !activePunishment(&punishment, now) {
+			return status.ErrAppealNotAllowed.Error
+		}
+
+		var latest PunishmentAppeals
+		r = tx.Order("id DESC").Limit(1).Find(&latest, "punishments_id = ?", punishmentID)
+		if r.Error != nil {
+			return r.Error
+		}
+		if r.RowsAffected != 0 {
+			if latest.State == AppealOpen {
+				return status.ErrAppealAlreadyOpen.Error
+			}
+			if latest.ReviewedAt != nil && now.Before(latest.ReviewedAt.Add(cooldown)) {
+				return status.ErrAppealCooldown.Error
+			}
+		}
+
+		appeal = PunishmentAppeals{
+			PunishmentsID: punishmentID,
+			State:         AppealOpen,
+			Message:       message,
+			PlayerUUID:    from.UUID,
+			PlayerName:    from.Name,
+			Date:          now,
+		}
+		if r := tx.Omit("Punishment").Create(&appeal); r.Error != nil {
+			return r.Error
+		}
+		appeal.Punishment = punishment
+		return nil
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[Appeal] Failed SubmitAppeal: %d (%s)", punishmentID, from.UUID)
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id":         appeal.ID,
+		"punishment": punishmentID,
+	}).Infof("[Appeal] %s submitted appeal", from.Name)
+	return &appeal, nil
+}
+
+// GetAppeals - Get appeals (newest first, filtered by state / appellant if specified)
+func (s *Mysql) GetAppeals(state AppealState, playerUUID string, limit int) ([]PunishmentAppeals, error) {
+	var appeals []PunishmentAppeals
+
+	q := s.client.Preload("Punishment").Order("date DESC, id DESC").Limit(limit)
+	if state != AppealAny {
+		q = q.Where("state = ?", state)
+	}
+	if playerUUID != "" {
+		q = q.Where("player_uuid = ?", playerUUID)
+	}
+
+	if r := q.Find(&appeals); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Appeal] Failed GetAppeals")
+		return nil, r.Error
+	}
+	return appeals, nil
+}
+
+// ReviewAppeal - Accept (revoke punishment) or deny open appeal
+func (s *Mysql) ReviewAppeal(id uint, reviewer PlayerIdentity, accept bool, note string) (*PunishmentAppeals, error) {
+	now := time.Now()
+	var appeal PunishmentAppeals
+
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		r := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Limit(1).Find(&appeal, "id = ?", id)
+		if r.Error != nil {
+			return r.Error
+		}
+		if r.RowsAffected == 0 {
+			return status.ErrAppealNotFound.Error
+		}
+		if appeal.State != AppealOpen {
+			return status.ErrAppealClosed.Error
+		}
+
+		appeal.State = AppealDenied
+		if accept {
+			appeal.State = AppealAccepted
+		}
+		appeal.ReviewerUUID = reviewer.UUID
+		appeal.ReviewerName = reviewer.Name
+		appeal.ReviewNote = note
+		appeal.ReviewedAt = &now
+
+		r = tx.Model(&appeal).Updates(map[string]interface{}{
+			"state":         appeal.State,
+			"reviewer_uuid": appeal.ReviewerUUID,
+			"reviewer_name": appeal.ReviewerName,
+			"review_note":   appeal.ReviewNote,
+			"reviewed_at":   appeal.ReviewedAt,
+		})
+		if r.Error != nil {
+			return r.Error
+		}
+
+		if r := tx.First(&appeal.Punishment, appeal.PunishmentsID); r.Error != nil {
+			return r.Error
+		}
+		if accept {
+			return revokePunishment(tx, &appeal.Punishment)
+		}
+		return nil
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[Appeal] Failed ReviewAppeal: %d", id)
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id":         appeal.ID,
+		"punishment": appeal.PunishmentsID,
+		"state":      appeal.State,
+	}).Infof("[Appeal] %s reviewed appeal of %s", reviewer.Name, appeal.PlayerName)
+	return &appeal, nil
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/synchthia/systera-api/status"
+)
+
+// activeBanID - ID of player's active ban
+func activeBanID(t *testing.T, db Storage, uuid string) uint {
+	t.Helper()
+
+	bans, err := db.GetPlayerPunishment(uuid, TEMPBAN, false)
+	if err != nil || len(bans) != 1 {
+		t.Fatalf("active bans of %s: %+v %v", uuid, bans, err)
+	}
+	return bans[0].ID
+}
+
+func TestAppeals(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		mustInit(t, db, steveUUID, "Steve")
+		mustPunish(t, db, steve, TEMPBAN, "grief", time.Now(), time.Now().Add(time.Hour).UnixMilli())
+		id := activeBanID(t, db, steveUUID)
+
+		if _, err := db.SubmitAppeal(id+100, steve, "sorry", time.Hour); !errors.Is(err, status.ErrPunishmentNotFound.Error) {
+			t.Fatalf("SubmitAppeal(missing punishment): %v", err)
+		}
+		if _, err := db.SubmitAppeal(id, alex, "not me", time.Hour); !errors.Is(err, status.ErrAppealNotAllowed.Error) {
+			t.Fatalf("SubmitAppeal(other player): %v", err)
+		}
+
+		appeal, err := db.SubmitAppeal(id, steve, "sorry", time.Hour)
+		if err != nil || appeal.State != AppealOpen || appeal.Punishment.ID != id {
+			t.Fatalf("SubmitAppeal: %+v %v", appeal, err)
+		}
+		if _, err := db.SubmitAppeal(id, steve, "sorry again", time.Hour); !errors.Is(err, status.ErrAppealAlreadyOpen.Error) {
+			t.Fatalf("SubmitAppeal(open): %v", err)
+		}
+
+		admin := PlayerIdentity{UUID: "00000000-0000-0000-0000-0000000000ff", Name: "Admin"}
+		denied, err := db.ReviewAppeal(appeal.ID, admin, false, "no")
+		if err != nil || denied.State != AppealDenied || denied.ReviewedAt == nil {
+			t.Fatalf("ReviewAppeal(deny): %+v %v", denied, err)
+		}
+		if _, err := db.ReviewAppeal(appeal.ID, admin, true, ""); !errors.Is(err, status.ErrAppealClosed.Error) {
+			t.Fatalf("ReviewAppeal(closed): %v", err)
+		}
+		if _, err := db.ReviewAppeal(appeal.ID+100, admin, true, ""); !errors.Is(err, status.ErrAppealNotFound.Error) {
+			t.Fatalf("ReviewAppeal(missing): %v", err)
+		}
+		if _, err := db.SubmitAppeal(id, steve, "please", time.Hour); !errors.Is(err, status.ErrAppealCooldown.Error) {
+			t.Fatalf("SubmitAppeal(cooldown): %v", err)
+		}
+
+		second, err := db.SubmitAppeal(id, steve, "please", 0)
+		if err != nil {
+			t.Fatalf("SubmitAppeal(after cooldown): %v", err)
+		}
+		if _, err := db.ReviewAppeal(second.ID, admin, true, "ok"); err != nil {
+			t.Fatalf("ReviewAppeal(accept): %v", err)
+		}
+		if bans, _ := db.GetPlayerPunishment(steveUUID, TEMPBAN, false); len(bans) != 0 {
+			t.Fatalf("ban not revoked by accepted appeal: %+v", bans)
+		}
+
+		open, _ := db.GetAppeals(AppealOpen, "", 10)
+		accepted, _ := db.GetAppeals(AppealAccepted, steveUUID, 10)
+		all, _ := db.GetAppeals(AppealAny, steveUUID, 10)
+		if len(open) != 0 || len(accepted) != 1 || len(all) != 2 || all[0].ID != second.ID {
+			t.Fatalf("GetAppeals: open=%d accepted=%d all=%+v", len(open), len(accepted), all)
+		}
+		if others, _ := db.GetAppeals(AppealAny, alexUUID, 10); len(others) != 0 {
+			t.Fatalf("GetAppeals(other player): %+v", others)
+		}
+	})
+}
@@ -0,0 +1,202 @@
+package database
+
+import (
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// CacheStats - Profile lookups served from cache / storage
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// staleFillWindow - Delay of second invalidation
+// A miss on other replica may read the row before the write and fill the shared cache after
+// the first delete; deleting again once the window passed drops such stale entries.
+const staleFillWindow = time.Second
+
+// CachedStorage - Read-through profile cache in front of Storage
+// Profiles are cached by UUID ("uuid:<uuid>") and names by lower name ("name:<name>" -> UUID).
+// Every write path of Players invalidates the cached profile.
+type CachedStorage struct {
+	Storage
+	cache ProfileCache
+
+	hits   uint64
+	misses uint64
+
+	// epoch - Incremented by every invalidation, fills started before it are discarded
+	epoch uint64
+}
+
+var _ Storage = (*CachedStorage)(nil)
+
+// NewCachedStorage - Create CachedStorage
+func NewCachedStorage(storage Storage, cache ProfileCache) *CachedStorage {
+	return &CachedStorage{
+		Storage: storage,
+		cache:   cache,
+	}
+}
+
+// Stats - Hit / miss count since start
+func (c *CachedStorage) Stats() CacheStats {
+	return CacheStats{
+		Hits:   atomic.LoadUint64(&c.hits),
+		Misses: atomic.LoadUint64(&c.misses),
+	}
+}
+
+func (c *CachedStorage) cached(uuid string) (Players, bool) {
+	data, ok := c.cache.Get("uuid:" + uuid)
+	if !ok {
+		return Players{}, false
+	}
+
+	var player Players
+	if err := json.Unmarshal(data, &player); err != nil {
+		logrus.WithError(err).Warnf("[Cache] Invalid cached profile (%s)", uuid)
+		return Players{}, false
+	}
+	return player, true
+}
+
+// fill - Store profile read by miss unless invalidated since epoch
+func (c *CachedStorage) fill(epoch uint64, player *Players) {
+	if atomic.LoadUint64(&c.epoch) != epoch {
+		return
+	}
+	c.store(player)
+}
+
+func (c *CachedStorage) store(player *Players) {
+	data, err := json.Marshal(player)
+	if err != nil {
+		logrus.WithError(err).Warnf("[Cache] Failed to encode profile (%s)", player.UUID)
+		return
+	}
+	c.cache.Set("uuid:"+player.UUID, data)
+	c.cache.Set("name:"+player.NameLower, []byte(player.UUID))
+}
+
+func (c *CachedStorage) invalidate(uuids ...string) {
+	if len(uuids) == 0 {
+		return
+	}
+
+	keys := make([]string, len(uuids))
+	for i, uuid := range uuids {
+		keys[i] = "uuid:" + uuid
+	}
+	c.delete(keys...)
+}
+
+// delete - Delete keys now and again after staleFillWindow
+func (c *CachedStorage) delete(keys ...string) {
+	atomic.AddUint64(&c.epoch, 1)
+	c.cache.Delete(keys...)
+	time.AfterFunc(staleFillWindow, func() {
+		c.cache.Delete(keys...)
+	})
+}
+
+// FindPlayer - Find PlayerProfile (cached)
+func (c *CachedStorage) FindPlayer(uuid string) (Players, error) {
+	if player, ok := c.cached(uuid); ok {
+		atomic.AddUint64(&c.hits, 1)
+		return player, nil
+	}
+	atomic.AddUint64(&c.misses, 1)
+
+	epoch := atomic.LoadUint64(&c.epoch)
+	player, err := c.Storage.FindPlayer(uuid)
+	if err != nil {
+		return player, err
+	}
+	c.fill(epoch, &player)
+	return player, nil
+}
+
+// FindPlayerByName - Find PlayerProfile from Name (cached)
+func (c *CachedStorage) FindPlayerByName(name string) (Players, error) {
+	lower := strings.ToLower(name)
+
+	// Name may have moved to other player since cached
+	if uuid, ok := c.cache.Get("name:" + lower); ok {
+		if player, ok := c.cached(string(uuid)); ok && player.NameLower == lower {
+			atomic.AddUint64(&c.hits, 1)
+			return player, nil
+		}
+	}
+	atomic.AddUint64(&c.misses, 1)
+
+	epoch := atomic.LoadUint64(&c.epoch)
+	player, err := c.Storage.FindPlayerByName(name)
+	if err != nil {
+		return player, err
+	}
+	c.fill(epoch, &player)
+	return player, nil
+}
+
+// InitPlayerProfile - Initialize Player Profile (cache is invalidated)
+func (c *CachedStorage) InitPlayerProfile(uuid, name, ipAddress, hostname string) (*PlayerLogin, error) {
+	login, err := c.Storage.InitPlayerProfile(uuid, name, ipAddress, hostname)
+	c.delete("uuid:"+uuid, "name:"+strings.ToLower(name))
+	return login, err
+}
+
+// SetPlayerGroups - Define Player Groups
+func (c *CachedStorage) SetPlayerGroups(uuid string, groups []string) error {
+	defer c.invalidate(uuid)
+	return c.Storage.SetPlayerGroups(uuid, groups)
+}
+
+// SetPlayerServer - Define Player Current Server
+func (c *CachedStorage) SetPlayerServer(isQuit bool, uuid, server string) error {
+	defer c.invalidate(uuid)
+	return c.Storage.SetPlayerServer(isQuit, uuid, server)
+}
+
+// SetPlayerSettings - Set Player Settings
+func (c *CachedStorage) SetPlayerSettings(uuid string, settings *PlayerSettings) error {
+	defer c.invalidate(uuid)
+	return c.Storage.SetPlayerSettings(uuid, settings)
+}
+
+// AddIgnore - Ignore player's activity
+func (c *CachedStorage) AddIgnore(uuid string, target *PlayerIdentity) error {
+	defer c.invalidate(uuid)
+	return c.Storage.AddIgnore(uuid, target)
+}
+
+// RemoveIgnore - UnIgnore player's activity
+func (c *CachedStorage) RemoveIgnore(uuid string, target *PlayerIdentity) error {
+	defer c.invalidate(uuid)
+	return c.Storage.RemoveIgnore(uuid, target)
+}
+
+// RenewPresence - Renew presence lease of players on server
+func (c *CachedStorage) RenewPresence(server string, uuids []string, expires time.Time) (int64, error) {
+	defer c.invalidate(uuids...)
+	return c.Storage.RenewPresence(server, uuids, expires)
+}
+
+// ExpirePresence - Clear current server of players whose lease lapsed before now
+func (c *CachedStorage) ExpirePresence(now time.Time) ([]string, error) {
+	expired, err := c.Storage.ExpirePresence(now)
+	c.invalidate(expired...)
+	return expired, err
+}
+
+// ClearServerPresence - Clear current server of all players on server
+func (c *CachedStorage) ClearServerPresence(server string, at time.Time) ([]string, error) {
+	cleared, err := c.Storage.ClearServerPresence(server, at)
+	c.invalidate(cleared...)
+	return cleared, err
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// blockingStorage - Storage whose FindPlayer waits for release after reading the row
+type blockingStorage struct {
+	Storage
+	read    chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingStorage) FindPlayer(uuid string) (Players, error) {
+	player, err := b.Storage.FindPlayer(uuid)
+	b.read <- struct{}{}
+	<-b.release
+	return player, err
+}
+
+func TestCachedStorageInvalidate(t *testing.T) {
+	c := NewCachedStorage(NewMemory(), NewLRUProfileCache(100, time.Minute))
+	mustInit(t, c, steveUUID, "Steve")
+
+	c.FindPlayer(steveUUID)
+	c.FindPlayer(steveUUID)
+	if stats := c.Stats(); stats.Hits != 1 || stats.Misses != 1 {
+		t.Fatalf("stats: %+v", stats)
+	}
+
+	if err := c.SetPlayerGroups(steveUUID, []string{"admin"}); err != nil {
+		t.Fatal(err)
+	}
+	if p, _ := c.FindPlayer(steveUUID); !strings.Contains(p.Groups, "admin") {
+		t.Fatalf("groups after write: %q", p.Groups)
+	}
+	if p, _ := c.FindPlayerByName("STEVE"); !strings.Contains(p.Groups, "admin") {
+		t.Fatalf("groups by name after write: %q", p.Groups)
+	}
+}
+
+func TestCachedStorageFillRace(t *testing.T) {
+	memory := NewMemory()
+	mustInit(t, memory, steveUUID, "Steve")
+
+	blocking := &blockingStorage{Storage: memory, read: make(chan struct{}), release: make(chan struct{})}
+	c := NewCachedStorage(blocking, NewLRUProfileCache(100, time.Minute))
+
+	// Miss reads the old row, then a write invalidates before the miss fills the cache
+	done := make(chan Players)
+	go func() {
+		p, _ := c.FindPlayer(steveUUID)
+		done <- p
+	}()
+	<-blocking.read
+	if err := c.SetPlayerGroups(steveUUID, []string{"admin"}); err != nil {
+		t.Fatal(err)
+	}
+	close(blocking.release)
+
+	if p := <-done; strings.Contains(p.Groups, "admin") {
+		t.Fatal("miss did not read the old row")
+	}
+
+	go func() { <-blocking.read }()
+	if p, _ := c.FindPlayer(steveUUID); !strings.Contains(p.Groups, "admin") {
+		t.Fatalf("stale profile cached after write: %q", p.Groups)
+	}
+}
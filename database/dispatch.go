@@ -0,0 +1,122 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/systerapb"
+	"gorm.io/gorm/clause"
+)
+
+// Dispatches - Dispatched command (audit trail)
+type Dispatches struct {
+	ID         uint   `gorm:"primary_key;AutoIncrement;"`
+	UUID       string `gorm:"index;unique;"` // correlation ID
+	Target     string `gorm:"index;"`
+	Command    string
+	IssuerUUID string
+	IssuerName string
+	Date       time.Time         `gorm:"type:datetime"`
+	Results    []DispatchResults `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// DispatchResults - Result reported by server
+type DispatchResults struct {
+	ID           uint   `gorm:"primary_key;AutoIncrement;"`
+	DispatchesID uint   `gorm:"foreign_key;index:idx_dispatch_result,unique;"` // foreignKey
+	ServerName   string `gorm:"index:idx_dispatch_result,unique;"`
+	Success      bool
+	Output       string
+	Date         time.Time `gorm:"type:datetime"`
+}
+
+// ToProtobuf - Convert to Protobuf
+func (d *Dispatches) ToProtobuf() *systerapb.DispatchEntry {
+	var results []*systerapb.DispatchResult
+	for _, r := range d.Results {
+		results = append(results, r.ToProtobuf())
+	}
+
+	return &systerapb.DispatchEntry{
+		Id:     d.UUID,
+		Target: d.Target,
+		Cmd:    d.Command,
+		From: &systerapb.PlayerIdentity{
+			Uuid: d.IssuerUUID,
+			Name: d.IssuerName,
+		},
+		Date:    d.Date.UnixMilli(),
+		Results: results,
+	}
+}
+
+// ToProtobuf - Convert to Protobuf
+func (r *DispatchResults) ToProtobuf() *systerapb.DispatchResult {
+	return &systerapb.DispatchResult{
+		ServerName: r.ServerName,
+		Success:    r.Success,
+		Output:     r.Output,
+		Date:       r.Date.UnixMilli(),
+	}
+}
+
+// CreateDispatch - Record dispatched command
+func (s *Mysql) CreateDispatch(d *Dispatches) error {
+	if r := s.client.Create(d); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Dispatch] Failed CreateDispatch")
+		return r.Error
+	}
+	return nil
+}
+
+// AddDispatchResult - Record (or overwrite) result reported by server
+func (s *Mysql) AddDispatchResult(id, serverName string, success bool, output string) error {
+	var d Dispatches
+	if r := s.client.First(&d, "uuid = ?", id); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Dispatch] Failed get dispatch (%s)", id)
+		return r.Error
+	}
+
+	result := &DispatchResults{
+		DispatchesID: d.ID,
+		ServerName:   serverName,
+		Success:      success,
+		Output:       output,
+		Date:         time.Now(),
+	}
+	r := s.client.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "dispatches_id"}, {Name: "server_name"}},
+		UpdateAll: true,
+	}).Create(result)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Dispatch] Failed AddDispatchResult (%s)", id)
+		return r.Error
+	}
+	return nil
+}
+
+// GetDispatch - Get dispatched command with results
+func (s *Mysql) GetDispatch(id string) (Dispatches, error) {
+	var d Dispatches
+	r := s.client.Preload("Results").First(&d, "uuid = ?", id)
+	if r.Error != nil {
+		return Dispatches{}, r.Error
+	}
+	return d, nil
+}
+
+// GetDispatchHistory - Get dispatched commands (newest first / all targets if target is empty)
+func (s *Mysql) GetDispatchHistory(target string, limit int) ([]Dispatches, error) {
+	var history []Dispatches
+
+	q := s.client.Model(&Dispatches{}).Preload("Results").Order("date DESC").Limit(limit)
+	if target != "" {
+		q = q.Where("target = ?", target)
+	}
+
+	if r := q.Find(&history); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Dispatch] Failed GetDispatchHistory")
+		return nil, r.Error
+	}
+	return history, nil
+}
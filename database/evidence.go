@@ -0,0 +1,149 @@
+package database
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/status"
+	"github.com/synchthia/systera-api/systerapb"
+	"gorm.io/gorm"
+)
+
+// Evidences - Evidence attached to punishment or report
+type Evidences struct {
+	ID            uint `gorm:"primary_key;AutoIncrement;"`
+	PunishmentsID uint `gorm:"index;"` // 0: attached to report
+	ReportID      uint `gorm:"index;"` // 0: attached to punishment
+	URL           string
+	Note          string `gorm:"type:text"`
+	UploaderUUID  string
+	UploaderName  string
+	Date          time.Time `gorm:"type:datetime"`
+	BlobKey       string    // ObjectStore key (empty: no blob)
+	BlobSize      int64
+	ContentType   string
+}
+
+// ToProtobuf - Convert to Protobuf
+func (e *Evidences) ToProtobuf() *systerapb.EvidenceEntry {
+	return &systerapb.EvidenceEntry{
+		Id:           uint32(e.ID),
+		PunishmentId: uint32(e.PunishmentsID),
+		ReportId:     uint32(e.ReportID),
+		Url:          e.URL,
+		Uploader: &systerapb.PlayerIdentity{
+			Uuid: e.UploaderUUID,
+			Name: e.UploaderName,
+		},
+		Note:        e.Note,
+		Date:        e.Date.UnixMilli(),
+		BlobSize:    e.BlobSize,
+		ContentType: e.ContentType,
+	}
+}
+
+func evidenceToProtobuf(evidence []Evidences) []*systerapb.EvidenceEntry {
+	var entries []*systerapb.EvidenceEntry
+	for i := range evidence {
+		entries = append(entries, evidence[i].ToProtobuf())
+	}
+	return entries
+}
+
+func preloadEvidence(db *gorm.DB) *gorm.DB {
+	return db.Order("date, id")
+}
+
+// putEvidenceBlob - Store blob of evidence (sets BlobKey / BlobSize)
+func putEvidenceBlob(objects ObjectStore, e *Evidences, blob []byte) error {
+	if len(blob) == 0 {
+		return nil
+	}
+	if objects == nil {
+		return status.ErrEvidenceBlobDisabled.Error
+	}
+
+	key := "evidence/" + uuid.NewString()
+	if err := objects.Put(key, blob); err != nil {
+		return err
+	}
+	e.BlobKey = key
+	e.BlobSize = int64(len(blob))
+	return nil
+}
+
+// getEvidenceBlob - Read blob of evidence (nil if evidence has no blob)
+func getEvidenceBlob(objects ObjectStore, e *Evidences) ([]byte, error) {
+	if e.BlobKey == "" {
+		return nil, nil
+	}
+	if objects == nil {
+		return nil, status.ErrEvidenceBlobDisabled.Error
+	}
+	return objects.Get(e.BlobKey)
+}
+
+// SetObjectStore - Set store of evidence blobs (blobs are rejected if not set)
+func (s *Mysql) SetObjectStore(objects ObjectStore) {
+	s.objects = objects
+}
+
+// AddEvidence - Attach evidence (and blob) to punishment or report
+func (s *Mysql) AddEvidence(e *Evidences, blob []byte) error {
+	var r *gorm.DB
+	if e.PunishmentsID != 0 {
+		r = s.client.Model(&Punishments{}).Limit(1).Find(&Punishments{}, "id = ?", e.PunishmentsID)
+	} else {
+		r = s.client.Model(&Report{}).Limit(1).Find(&Report{}, "id = ?", e.ReportID)
+	}
+	if r.Error != nil {
+		return r.Error
+	}
+	if r.RowsAffected == 0 {
+		if e.PunishmentsID != 0 {
+			return status.ErrPunishmentNotFound.Error
+		}
+		return status.ErrReportNotFound.Error
+	}
+
+	if err := putEvidenceBlob(s.objects, e, blob); err != nil {
+		logrus.WithError(err).Errorf("[Evidence] Failed to store blob")
+		return err
+	}
+
+	if r := s.client.Create(e); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Evidence] Failed AddEvidence")
+		if e.BlobKey != "" {
+			s.objects.Delete(e.BlobKey)
+		}
+		return r.Error
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id":         e.ID,
+		"punishment": e.PunishmentsID,
+		"report":     e.ReportID,
+		"blob":       e.BlobSize,
+	}).Infof("[Evidence] %s attached evidence", e.UploaderName)
+	return nil
+}
+
+// GetEvidenceBlob - Get evidence with blob (blob is nil if not attached)
+func (s *Mysql) GetEvidenceBlob(id uint) (*Evidences, []byte, error) {
+	var e Evidences
+	r := s.client.Limit(1).Find(&e, "id = ?", id)
+	if r.Error != nil {
+		return nil, nil, r.Error
+	}
+	if r.RowsAffected == 0 {
+		return nil, nil, status.ErrEvidenceNotFound.Error
+	}
+
+	blob, err := getEvidenceBlob(s.objects, &e)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Evidence] Failed to read blob (%d)", id)
+		return nil, nil, err
+	}
+	return &e, blob, nil
+}
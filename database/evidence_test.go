@@ -0,0 +1,101 @@
+package database
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/synchthia/systera-api/status"
+)
+
+func TestEvidence(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		mustInit(t, db, steveUUID, "Steve")
+		mustPunish(t, db, steve, TEMPBAN, "grief", time.Now(), time.Now().Add(time.Hour).UnixMilli())
+		punishmentID := activeBanID(t, db, steveUUID)
+		report, err := db.SetReport(alex, steve, "lobby", "grief")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Blobs are rejected by every backend until an object store is set
+		blob := []byte("\x89PNG screenshot")
+		if err := db.AddEvidence(&Evidences{PunishmentsID: punishmentID}, blob); !errors.Is(err, status.ErrEvidenceBlobDisabled.Error) {
+			t.Fatalf("AddEvidence(blob without store): %v", err)
+		}
+
+		if err := db.AddEvidence(&Evidences{PunishmentsID: punishmentID + 100, URL: "https://example.net/1"}, nil); !errors.Is(err, status.ErrPunishmentNotFound.Error) {
+			t.Fatalf("AddEvidence(missing punishment): %v", err)
+		}
+		if err := db.AddEvidence(&Evidences{ReportID: report.ID + 100, URL: "https://example.net/1"}, nil); !errors.Is(err, status.ErrReportNotFound.Error) {
+			t.Fatalf("AddEvidence(missing report): %v", err)
+		}
+
+		if err := db.AddEvidence(&Evidences{PunishmentsID: punishmentID, URL: "https://example.net/clip"}, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.AddEvidence(&Evidences{ReportID: report.ID, URL: "https://example.net/report"}, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		db.(interface{ SetObjectStore(ObjectStore) }).SetObjectStore(NewMemoryObjectStore())
+		e := &Evidences{PunishmentsID: punishmentID, ContentType: "image/png"}
+		if err := db.AddEvidence(e, blob); err != nil {
+			t.Fatal(err)
+		}
+		if e.BlobKey == "" || e.BlobSize != int64(len(blob)) {
+			t.Fatalf("blob not recorded: %+v", e)
+		}
+
+		got, data, err := db.GetEvidenceBlob(e.ID)
+		if err != nil || got.ContentType != "image/png" || !bytes.Equal(data, blob) {
+			t.Fatalf("GetEvidenceBlob: %+v %q %v", got, data, err)
+		}
+		if _, _, err := db.GetEvidenceBlob(e.ID + 100); !errors.Is(err, status.ErrEvidenceNotFound.Error) {
+			t.Fatalf("GetEvidenceBlob(missing): %v", err)
+		}
+
+		punishments, _ := db.GetPlayerPunishment(steveUUID, TEMPBAN, false)
+		if len(punishments) != 1 || len(punishments[0].Evidence) != 2 {
+			t.Fatalf("punishment evidence: %+v", punishments)
+		}
+		reports, _ := db.GetReports(steveUUID, 10)
+		if len(reports) != 1 || len(reports[0].Evidence) != 1 || reports[0].Evidence[0].URL != "https://example.net/report" {
+			t.Fatalf("report evidence: %+v", reports)
+		}
+	})
+}
+
+func TestLocalObjectStore(t *testing.T) {
+	store, err := NewLocalObjectStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Put("evidence/a", []byte("one")); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("evidence/a", []byte("two")); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := store.Get("evidence/a"); err != nil || string(data) != "two" {
+		t.Fatalf("Get: %q %v", data, err)
+	}
+
+	if err := store.Delete("evidence/a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get("evidence/a"); err != ErrObjectNotFound {
+		t.Fatalf("Get(deleted): %v", err)
+	}
+	if err := store.Delete("evidence/a"); err != nil {
+		t.Fatalf("Delete(missing): %v", err)
+	}
+
+	for _, key := range []string{"", "../escape", "evidence/../../escape", "/abs", `evidence\a`} {
+		if err := store.Put(key, []byte("x")); err == nil {
+			t.Errorf("Put(%q): expected invalid key error", key)
+		}
+	}
+}
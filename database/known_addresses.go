@@ -2,6 +2,8 @@ package database
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // PlayerAddresses - Player Address Entries
@@ -17,12 +19,16 @@ type PlayerAddresses struct {
 // 同じプレイヤーIDで同じアドレスならLastSeen上書き
 // プレイヤーIDとアドレスの2つの組み合わせがユニークでなくてはならない
 func (s *Mysql) UpdateKnownAddress(playerUUID, address, hostname string) error {
+	return updateKnownAddress(s.client, playerUUID, address, hostname)
+}
+
+func updateKnownAddress(tx *gorm.DB, playerUUID, address, hostname string) error {
 	nowtime := time.Now()
 
 	var pa []PlayerAddresses
 
 	// 同一UUIDで、同じアドレスの項目を取得
-	r := s.client.Where("player_uuid = ?", playerUUID).Where("address = ?", address).Find(&pa)
+	r := tx.Where("player_uuid = ?", playerUUID).Where("address = ?", address).Find(&pa)
 	if r.Error != nil {
 		return r.Error
 	}
@@ -6,49 +6,92 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/synchthia/systera-api/status"
+	"github.com/synchthia/systera-api/systerapb"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
-// KnownUsernames - Player name change logs
+// KnownUsernames - Player name change logs (append-only, one row per name change)
 type KnownUsernames struct {
 	ID            uint   `gorm:"primary_key;AutoIncrement;"`
 	PlayerUUID    string `gorm:"index;"`
-	Username      string `gorm:"unique;"`
-	UsernameLower string
+	Username      string
+	UsernameLower string `gorm:"index;"`
+	FirstSeen     time.Time
 	LastUsed      time.Time
+	ReleasedAt    *time.Time // name was taken by another player
 }
 
+// ToProtobuf - Convert to Protobuf
+func (k *KnownUsernames) ToProtobuf() *systerapb.NameHistoryEntry {
+	e := &systerapb.NameHistoryEntry{
+		Name:      k.Username,
+		FirstSeen: k.FirstSeen.UnixMilli(),
+		LastUsed:  k.LastUsed.UnixMilli(),
+	}
+	if k.ReleasedAt != nil {
+		e.ReleasedAt = k.ReleasedAt.UnixMilli()
+	}
+	return e
+}
+
+// UpdateKnownUsername - Append name to history (or renew current name)
 func (s *Mysql) UpdateKnownUsername(playerUUID, username string) error {
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		return updateKnownUsername(tx, playerUUID, username)
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[KnownUsername] Failed UpdateKnownUsername: %s(%s)", username, playerUUID)
+	}
+	return err
+}
+
+func updateKnownUsername(tx *gorm.DB, playerUUID, username string) error {
 	nowtime := time.Now()
+	lower := strings.ToLower(username)
 
-	k := &KnownUsernames{
-		PlayerUUID:    playerUUID,
-		Username:      username,
-		UsernameLower: strings.ToLower(username),
-		LastUsed:      nowtime,
+	var latest KnownUsernames
+	r := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("player_uuid = ?", playerUUID).
+		Order("id DESC").Limit(1).Find(&latest)
+	if r.Error != nil {
+		return r.Error
 	}
 
-	r := s.client.Model(&k).Where("player_uuid = ?", playerUUID).Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "player_uuid"}},
-		UpdateAll: true,
-	}).Create(&k)
-
+	if r.RowsAffected != 0 && latest.Username == username {
+		r = tx.Model(&latest).Updates(map[string]interface{}{
+			"last_used":   nowtime,
+			"released_at": nil,
+		})
+	} else {
+		r = tx.Create(&KnownUsernames{
+			PlayerUUID:    playerUUID,
+			Username:      username,
+			UsernameLower: lower,
+			FirstSeen:     nowtime,
+			LastUsed:      nowtime,
+		})
+	}
 	if r.Error != nil {
 		return r.Error
 	}
-	return nil
+
+	// Name moved from other player
+	return tx.Model(&KnownUsernames{}).
+		Where("username_lower = ? AND player_uuid <> ? AND released_at IS NULL", lower, playerUUID).
+		Update("released_at", nowtime).Error
 }
 
+// GetIdentityByName - Resolve name (current holder first, then latest previous holder)
 func (s *Mysql) GetIdentityByName(username string) (*PlayerIdentity, error) {
 	var col *KnownUsernames
 	r := s.client.Model(&col).
-        Where("username_lower = ?", strings.ToLower(username)).
-        Order("last_used DESC").First(&col)
+		Where("username_lower = ?", strings.ToLower(username)).
+		Order("released_at IS NULL DESC, last_used DESC").First(&col)
 
 	if r.Error != nil {
 		if r.Error != gorm.ErrRecordNotFound {
-            logrus.WithError(r.Error).Errorf("[GetIdentity] get identity failed: %v", username)
+			logrus.WithError(r.Error).Errorf("[GetIdentity] get identity failed: %v", username)
 			return nil, r.Error
 		} else {
 			return nil, status.ErrPlayerNotFound.Error
@@ -60,3 +103,14 @@ func (s *Mysql) GetIdentityByName(username string) (*PlayerIdentity, error) {
 		Name: col.Username,
 	}, r.Error
 }
+
+// GetNameHistory - Name history of player (newest first)
+func (s *Mysql) GetNameHistory(playerUUID string) ([]KnownUsernames, error) {
+	var history []KnownUsernames
+	r := s.client.Where("player_uuid = ?", playerUUID).Order("id DESC").Find(&history)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[KnownUsername] Failed GetNameHistory (%s)", playerUUID)
+		return nil, r.Error
+	}
+	return history, nil
+}
@@ -0,0 +1,1462 @@
+package database
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/synchthia/systera-api/status"
+	"gorm.io/gorm"
+)
+
+// Memory - In-memory implementation of Storage (not persisted, single process)
+type Memory struct {
+	mu       sync.Mutex
+	resolver UUIDResolver
+	nextID   uint
+
+	players       map[string]*Players
+	addresses     []PlayerAddresses
+	usernames     []KnownUsernames
+	groups        []Groups
+	punishments   []Punishments
+	appeals       []PunishmentAppeals
+	evidence      []Evidences
+	notes         []PlayerNotes
+	objects       ObjectStore
+	reports       []Report
+	dispatches    []Dispatches
+	announcements []ScheduledAnnouncements
+	servers       map[string]*Servers
+	serverGroups  []ServerGroups
+	sessions      []PlayerSessions
+}
+
+var _ Storage = (*Memory)(nil)
+
+// NewMemory - Create empty in-memory storage (unknown names are resolved to offline UUIDs)
+// Like Mysql, evidence blobs are rejected until SetObjectStore.
+func NewMemory() *Memory {
+	return &Memory{
+		resolver: OfflineResolver{},
+		players:  make(map[string]*Players),
+		servers:  make(map[string]*Servers),
+	}
+}
+
+// SetUUIDResolver - Set resolver used by NameToUUID for unknown names
+func (m *Memory) SetUUIDResolver(resolver UUIDResolver) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.resolver = resolver
+}
+
+func (m *Memory) id() uint {
+	m.nextID++
+	return m.nextID
+}
+
+// copyPlayer - Copy player (slices are not shared with caller)
+func copyPlayer(p *Players) Players {
+	c := *p
+	c.IgnoreList = append([]IgnoreEntry(nil), p.IgnoreList...)
+	return c
+}
+
+// NameToUUID - Get UUID from Player Name (UUIDResolver if not known)
+func (m *Memory) NameToUUID(name string) (string, error) {
+	pi, err := m.GetIdentityByName(name)
+	if err == status.ErrPlayerNotFound.Error {
+		m.mu.Lock()
+		resolver := m.resolver
+		m.mu.Unlock()
+		return resolver.ResolveUUID(name)
+	} else if err != nil {
+		return "", err
+	}
+	return pi.UUID, nil
+}
+
+// GetIdentityByName - Resolve name (current holder first, then latest previous holder)
+func (m *Memory) GetIdentityByName(username string) (*PlayerIdentity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	lower := strings.ToLower(username)
+	var found *KnownUsernames
+	for i := range m.usernames {
+		k := &m.usernames[i]
+		if k.UsernameLower != lower {
+			continue
+		}
+		if found == nil ||
+			(found.ReleasedAt != nil && k.ReleasedAt == nil) ||
+			((found.ReleasedAt == nil) == (k.ReleasedAt == nil) && k.LastUsed.After(found.LastUsed)) {
+			found = k
+		}
+	}
+	if found == nil {
+		return nil, status.ErrPlayerNotFound.Error
+	}
+	return &PlayerIdentity{UUID: found.PlayerUUID, Name: found.Username}, nil
+}
+
+// GetNameHistory - Name history of player (newest first)
+func (m *Memory) GetNameHistory(playerUUID string) ([]KnownUsernames, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var history []KnownUsernames
+	for i := len(m.usernames) - 1; i >= 0; i-- {
+		if m.usernames[i].PlayerUUID == playerUUID {
+			history = append(history, m.usernames[i])
+		}
+	}
+	return history, nil
+}
+
+// updateKnownUsername - Same rules as Mysql.UpdateKnownUsername
+func (m *Memory) updateKnownUsername(playerUUID, username string, now time.Time) {
+	lower := strings.ToLower(username)
+
+	latest := -1
+	for i := range m.usernames {
+		if m.usernames[i].PlayerUUID == playerUUID {
+			latest = i
+		}
+	}
+	if latest >= 0 && m.usernames[latest].Username == username {
+		m.usernames[latest].LastUsed = now
+		m.usernames[latest].ReleasedAt = nil
+	} else {
+		m.usernames = append(m.usernames, KnownUsernames{
+			ID:            m.id(),
+			PlayerUUID:    playerUUID,
+			Username:      username,
+			UsernameLower: lower,
+			FirstSeen:     now,
+			LastUsed:      now,
+		})
+	}
+
+	for i := range m.usernames {
+		k := &m.usernames[i]
+		if k.UsernameLower == lower && k.PlayerUUID != playerUUID && k.ReleasedAt == nil {
+			released := now
+			k.ReleasedAt = &released
+		}
+	}
+}
+
+// FindPlayer - Find PlayerProfile
+func (m *Memory) FindPlayer(uuid string) (Players, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.players[uuid]
+	if !ok {
+		return Players{}, gorm.ErrRecordNotFound
+	}
+	return copyPlayer(p), nil
+}
+
+// FindPlayerByName - Find PlayerProfile from Name (latest login first)
+func (m *Memory) FindPlayerByName(name string) (Players, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	lower := strings.ToLower(name)
+	var found *Players
+	for _, p := range m.players {
+		if p.NameLower == lower && (found == nil || p.LastLogin.After(found.LastLogin)) {
+			found = p
+		}
+	}
+	if found == nil {
+		return Players{}, gorm.ErrRecordNotFound
+	}
+	return copyPlayer(found), nil
+}
+
+// InitPlayerProfile - Initialize Player Profile
+func (m *Memory) InitPlayerProfile(uuid, name, ipAddress, hostname string) (*PlayerLogin, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	nowtime := time.Now()
+	login := &PlayerLogin{}
+
+	p, ok := m.players[uuid]
+	if ok {
+		login.PreviousName = p.Name
+	} else {
+		login.FirstJoin = true
+		p = &Players{
+			ID:         m.id(),
+			UUID:       uuid,
+			FirstLogin: nowtime,
+			Settings: PlayerSettings{
+				JoinMessage: true,
+				Japanize:    true,
+				Vanish:      false,
+				GlobalChat:  true,
+			},
+		}
+		p.Settings.PlayersID = p.ID
+		m.players[uuid] = p
+	}
+
+	p.Name = name
+	p.NameLower = strings.ToLower(name)
+	p.LastLogin = nowtime
+	if len(p.Groups) == 0 {
+		p.Groups = "default"
+	}
+
+	m.updateKnownAddress(uuid, ipAddress, hostname, nowtime)
+	m.updateKnownUsername(uuid, name, nowtime)
+
+	login.Player = copyPlayer(p)
+	login.Ban = m.activeBan(uuid, nowtime)
+	return login, nil
+}
+
+// SetPlayerGroups - Define Player Groups
+func (m *Memory) SetPlayerGroups(uuid string, groups []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.players[uuid]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+
+	p.Groups = "default"
+	for _, g := range groups {
+		if g != "default" {
+			p.Groups += "," + g
+		}
+	}
+	return nil
+}
+
+// SetPlayerServer - Define Player Current Server (and record session)
+func (m *Memory) SetPlayerServer(isQuit bool, uuid, server string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.players[uuid]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+
+	was := p.CurrentServer
+	if isQuit {
+		if p.CurrentServer != server {
+			return nil
+		}
+		p.CurrentServer = ""
+	} else {
+		p.CurrentServer = server
+	}
+
+	now := time.Now()
+	p.PresenceExpiresAt = nil
+	if p.CurrentServer != "" {
+		expires := now.Add(DefaultPresenceTTL)
+		p.PresenceExpiresAt = &expires
+	}
+
+	if was != p.CurrentServer {
+		m.closeSessions(uuid, now)
+		if p.CurrentServer != "" {
+			m.openSession(uuid, p.CurrentServer, now)
+		}
+	}
+	return nil
+}
+
+// SetPlayerSettings - Set Player Settings
+func (m *Memory) SetPlayerSettings(uuid string, settings *PlayerSettings) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.players[uuid]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+
+	p.Settings.JoinMessage = settings.JoinMessage
+	p.Settings.Vanish = settings.Vanish
+	p.Settings.Japanize = settings.Japanize
+	p.Settings.GlobalChat = settings.GlobalChat
+	return nil
+}
+
+// AddIgnore - Ignore player's activity (chat etc.)
+func (m *Memory) AddIgnore(uuid string, target *PlayerIdentity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.players[uuid]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+
+	for _, il := range p.IgnoreList {
+		if target.UUID == il.UUID {
+			return status.ErrPlayerAlreadyExists.Error
+		}
+	}
+
+	entry := target.ToIgnoreEntry()
+	entry.PlayersID = p.ID
+	p.IgnoreList = append(p.IgnoreList, *entry)
+	return nil
+}
+
+// RemoveIgnore - UnIgnore player's activity (chat etc.)
+func (m *Memory) RemoveIgnore(uuid string, target *PlayerIdentity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.players[uuid]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+
+	var list []IgnoreEntry
+	for _, il := range p.IgnoreList {
+		if il.UUID != target.UUID {
+			list = append(list, il)
+		}
+	}
+	p.IgnoreList = list
+	return nil
+}
+
+// CountOnlinePlayers - Count players per current server
+func (m *Memory) CountOnlinePlayers() (map[string]int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	counts := make(map[string]int64)
+	for _, p := range m.players {
+		if p.CurrentServer != "" {
+			counts[p.CurrentServer]++
+		}
+	}
+	return counts, nil
+}
+
+// RenewPresence - Renew presence lease of players on server
+func (m *Memory) RenewPresence(server string, uuids []string, expires time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	var renewed int64
+	for _, uuid := range uuids {
+		p, ok := m.players[uuid]
+		if !ok || (p.CurrentServer != server && p.CurrentServer != "") {
+			continue
+		}
+		if p.CurrentServer == "" {
+			m.openSession(uuid, server, now)
+		}
+
+		e := expires
+		p.CurrentServer = server
+		p.PresenceExpiresAt = &e
+		renewed++
+	}
+	return renewed, nil
+}
+
+// ExpirePresence - Clear current server of players whose lease lapsed before now (returns expired UUIDs)
+func (m *Memory) ExpirePresence(now time.Time) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var expired []string
+	for uuid, p := range m.players {
+		if p.CurrentServer == "" || (p.PresenceExpiresAt != nil && !p.PresenceExpiresAt.Before(now)) {
+			continue
+		}
+		m.closeSessions(uuid, sessionEnd(p.PresenceExpiresAt, now))
+		p.CurrentServer = ""
+		p.PresenceExpiresAt = nil
+		expired = append(expired, uuid)
+	}
+	return expired, nil
+}
+
+// ClearServerPresence - Clear current server of all players on server (returns cleared UUIDs)
+func (m *Memory) ClearServerPresence(server string, at time.Time) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var cleared []string
+	for uuid, p := range m.players {
+		if p.CurrentServer != server {
+			continue
+		}
+		m.closeSessions(uuid, at)
+		p.CurrentServer = ""
+		p.PresenceExpiresAt = nil
+		cleared = append(cleared, uuid)
+	}
+	return cleared, nil
+}
+
+// GetOnlinePlayers - Get online players (all servers if server is empty)
+func (m *Memory) GetOnlinePlayers(server string) ([]OnlinePlayer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var players []OnlinePlayer
+	for _, p := range m.players {
+		if p.CurrentServer == "" || (server != "" && p.CurrentServer != server) {
+			continue
+		}
+		players = append(players, OnlinePlayer{
+			UUID:          p.UUID,
+			Name:          p.Name,
+			CurrentServer: p.CurrentServer,
+		})
+	}
+	sort.Slice(players, func(i, j int) bool {
+		return players[i].Name < players[j].Name
+	})
+	return players, nil
+}
+
+// UpdateKnownAddress - Record address (LastSeen is updated for known address)
+func (m *Memory) UpdateKnownAddress(playerUUID, address, hostname string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.updateKnownAddress(playerUUID, address, hostname, time.Now())
+	return nil
+}
+
+func (m *Memory) updateKnownAddress(playerUUID, address, hostname string, now time.Time) {
+	for i := range m.addresses {
+		a := &m.addresses[i]
+		if a.PlayerUUID == playerUUID && a.Address == address {
+			a.Hostname = hostname
+			a.LastSeen = now
+			return
+		}
+	}
+
+	m.addresses = append(m.addresses, PlayerAddresses{
+		ID:         m.id(),
+		PlayerUUID: playerUUID,
+		Address:    address,
+		Hostname:   hostname,
+		FirstSeen:  now,
+		LastSeen:   now,
+	})
+}
+
+// AltLookup - AltLookup player accounts (not implemented, same as Mysql)
+func (m *Memory) AltLookup(playerUUID string) ([]AltLookupData, error) {
+	return nil, nil
+}
+
+// copyGroup - Copy group (slices are not shared with caller)
+func copyGroup(g *Groups) Groups {
+	c := *g
+	c.Permissions = append([]Permissions(nil), g.Permissions...)
+	return c
+}
+
+func (m *Memory) findGroup(name string) *Groups {
+	for i := range m.groups {
+		if m.groups[i].Name == name {
+			return &m.groups[i]
+		}
+	}
+	return nil
+}
+
+// GetGroupData - Get Group Entry (empty group if not exists, same as Mysql)
+func (m *Memory) GetGroupData(name string) (Groups, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if g := m.findGroup(name); g != nil {
+		return copyGroup(g), nil
+	}
+	return Groups{}, nil
+}
+
+// GetAllGroup - Find All Group Entry
+func (m *Memory) GetAllGroup() ([]Groups, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var groups []Groups
+	for i := range m.groups {
+		groups = append(groups, copyGroup(&m.groups[i]))
+	}
+	return groups, nil
+}
+
+// CreateGroup - Create New Group
+func (m *Memory) CreateGroup(group Groups) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.findGroup(group.Name) != nil {
+		return errors.New("group already exists")
+	}
+
+	group.ID = m.id()
+	for i := range group.Permissions {
+		group.Permissions[i].ID = m.id()
+		group.Permissions[i].GroupsID = group.ID
+	}
+	m.groups = append(m.groups, copyGroup(&group))
+	return nil
+}
+
+// RemoveGroup - Remove Group
+func (m *Memory) RemoveGroup(groupName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var groups []Groups
+	for _, g := range m.groups {
+		if g.Name != groupName {
+			groups = append(groups, g)
+		}
+	}
+	m.groups = groups
+	return nil
+}
+
+// UpdateGroup - Update Group (prefix and permissions given are merged, same as Mysql)
+func (m *Memory) UpdateGroup(newGroup Groups) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g := m.findGroup(newGroup.Name)
+	if g == nil {
+		return errors.New("group does not exists")
+	}
+
+	g.Prefix = newGroup.Prefix
+	for _, p := range newGroup.Permissions {
+		if !hasPermission(g, p.ServerName, p.Permission) {
+			g.Permissions = append(g.Permissions, Permissions{
+				ID:         m.id(),
+				GroupsID:   g.ID,
+				ServerName: p.ServerName,
+				Permission: p.Permission,
+			})
+		}
+	}
+	return nil
+}
+
+func hasPermission(g *Groups, target, permission string) bool {
+	for _, p := range g.Permissions {
+		if p.ServerName == target && p.Permission == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// AddPermission - Add Permission
+func (m *Memory) AddPermission(groupName, target string, permissions []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g := m.findGroup(groupName)
+	if g == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	for _, v := range permissions {
+		if hasPermission(g, target, v) {
+			return errors.New("permission already exists")
+		}
+	}
+	for _, v := range permissions {
+		g.Permissions = append(g.Permissions, Permissions{
+			ID:         m.id(),
+			GroupsID:   g.ID,
+			ServerName: target,
+			Permission: v,
+		})
+	}
+	return nil
+}
+
+// RemovePermission - Remove Permission
+func (m *Memory) RemovePermission(groupName, target string, permissions []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	g := m.findGroup(groupName)
+	if g == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	remove := make(map[string]bool)
+	for _, v := range permissions {
+		remove[v] = true
+	}
+
+	var perms []Permissions
+	for _, p := range g.Permissions {
+		if p.ServerName == target && remove[p.Permission] {
+			continue
+		}
+		perms = append(perms, p)
+	}
+	g.Permissions = perms
+	return nil
+}
+
+// GetPlayerPunishment - Get Player Punishment History (ordered by date)
+func (m *Memory) GetPlayerPunishment(playerUUID string, filterLevel PunishLevel, includeExpired bool) ([]Punishments, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	punishments := m.getPlayerPunishment(playerUUID, filterLevel, includeExpired)
+	for i := range punishments {
+		punishments[i].Evidence = m.evidenceOf(punishments[i].ID, 0)
+	}
+	return punishments, nil
+}
+
+func (m *Memory) getPlayerPunishment(playerUUID string, filterLevel PunishLevel, includeExpired bool) []Punishments {
+	now := time.Now()
+
+	var punishments []Punishments
+	for i := range m.punishments {
+		p := &m.punishments[i]
+		if p.TargetPlayerUUID != playerUUID || p.Level < filterLevel {
+			continue
+		}
+		if !includeExpired && !activePunishment(p, now) {
+			continue
+		}
+		punishments = append(punishments, *p)
+	}
+	sort.SliceStable(punishments, func(i, j int) bool {
+		return punishments[i].Date.Before(punishments[j].Date)
+	})
+	return punishments
+}
+
+// activeBan - Same rules as Mysql activeBan
+func (m *Memory) activeBan(playerUUID string, now time.Time) *Punishments {
+	var ban *Punishments
+	for i := range m.punishments {
+		p := &m.punishments[i]
+		if p.TargetPlayerUUID != playerUUID || p.Level < TEMPBAN || !activePunishment(p, now) {
+			continue
+		}
+		if ban == nil || p.Level > ban.Level || (p.Level == ban.Level && p.Date.After(ban.Date)) {
+			ban = p
+		}
+	}
+	if ban == nil {
+		return nil
+	}
+	c := *ban
+	return &c
+}
+
+// SetPlayerPunishment - Punish Player (same rules as Mysql)
+func (m *Memory) SetPlayerPunishment(force bool, from, to PlayerIdentity, level PunishLevel, reason string, date, expire int64) (bool, PunishRule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := PunishRule{}
+	for _, ban := range m.getPlayerPunishment(to.UUID, TEMPBAN, false) {
+		if ban.Level == PERMBAN {
+			result.Duplicate = true
+			return false, result, nil
+		}
+		if level <= TEMPBAN && ban.Level == TEMPBAN {
+			result.Cooldown = true
+		}
+	}
+
+	if p, ok := m.players[to.UUID]; !ok {
+		result.NoProfile = true
+	} else if p.CurrentServer == "" {
+		result.Offline = true
+	}
+
+	if !force && result.NoProfile {
+		return false, result, nil
+	}
+	if result.Cooldown && level == TEMPBAN {
+		return false, result, nil
+	}
+	if !force && result.Offline && (level == WARN || level == KICK) {
+		return false, result, nil
+	}
+
+	m.punishments = append(m.punishments, Punishments{
+		ID:                 m.id(),
+		Available:          true,
+		Level:              level,
+		Reason:             reason,
+		Date:               time.UnixMilli(date),
+		Expire:             time.UnixMilli(expire),
+		PunisherPlayerUUID: from.UUID,
+		PunisherPlayerName: from.Name,
+		TargetPlayerUUID:   to.UUID,
+		TargetPlayerName:   to.Name,
+	})
+	return true, result, nil
+}
+
+// UnBan - Disable available tempban/permban
+func (m *Memory) UnBan(targetUUID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p := m.getPlayerPunishment(targetUUID, TEMPBAN, false)
+	if len(p) == 0 {
+		return errors.New("player not punished")
+	}
+
+	m.revokePunishment(p[len(p)-1].ID)
+	return nil
+}
+
+// revokePunishment - Disable punishment (UnBan / accepted appeal)
+func (m *Memory) revokePunishment(id uint) {
+	for i := range m.punishments {
+		if m.punishments[i].ID == id {
+			m.punishments[i].Available = false
+		}
+	}
+}
+
+func (m *Memory) punishment(id uint) *Punishments {
+	for i := range m.punishments {
+		if m.punishments[i].ID == id {
+			return &m.punishments[i]
+		}
+	}
+	return nil
+}
+
+// SubmitAppeal - Appeal active punishment (same rules as Mysql)
+func (m *Memory) SubmitAppeal(punishmentID uint, from PlayerIdentity, message string, cooldown time.Duration) (*PunishmentAppeals, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	p := m.punishment(punishmentID)
+	if p == nil {
+		return nil, status.ErrPunishmentNotFound.Error
+	}
+	if p.TargetPlayerUUID != from.UUID || !activePunishment(p, now) {
+		return nil, status.ErrAppealNotAllowed.Error
+	}
+
+	for i := len(m.appeals) - 1; i >= 0; i-- {
+		latest := &m.appeals[i]
+		if latest.PunishmentsID != punishmentID {
+			continue
+		}
+		if latest.State == AppealOpen {
+			return nil, status.ErrAppealAlreadyOpen.Error
+		}
+		if latest.ReviewedAt != nil && now.Before(latest.ReviewedAt.Add(cooldown)) {
+			return nil, status.ErrAppealCooldown.Error
+		}
+		break
+	}
+
+	m.appeals = append(m.appeals, PunishmentAppeals{
+		ID:            m.id(),
+		PunishmentsID: punishmentID,
+		State:         AppealOpen,
+		Message:       message,
+		PlayerUUID:    from.UUID,
+		PlayerName:    from.Name,
+		Date:          now,
+	})
+	a := m.appeals[len(m.appeals)-1]
+	a.Punishment = *p
+	return &a, nil
+}
+
+// GetAppeals - Get appeals (newest first, filtered by state / appellant if specified)
+func (m *Memory) GetAppeals(state AppealState, playerUUID string, limit int) ([]PunishmentAppeals, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var appeals []PunishmentAppeals
+	for i := len(m.appeals) - 1; i >= 0 && len(appeals) < limit; i-- {
+		a := m.appeals[i]
+		if (state != AppealAny && a.State != state) || (playerUUID != "" && a.PlayerUUID != playerUUID) {
+			continue
+		}
+		if p := m.punishment(a.PunishmentsID); p != nil {
+			a.Punishment = *p
+		}
+		appeals = append(appeals, a)
+	}
+	return appeals, nil
+}
+
+// ReviewAppeal - Accept (revoke punishment) or deny open appeal
+func (m *Memory) ReviewAppeal(id uint, reviewer PlayerIdentity, accept bool, note string) (*PunishmentAppeals, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var a *PunishmentAppeals
+	for i := range m.appeals {
+		if m.appeals[i].ID == id {
+			a = &m.appeals[i]
+		}
+	}
+	if a == nil {
+		return nil, status.ErrAppealNotFound.Error
+	}
+	if a.State != AppealOpen {
+		return nil, status.ErrAppealClosed.Error
+	}
+
+	now := time.Now()
+	a.State = AppealDenied
+	if accept {
+		a.State = AppealAccepted
+		m.revokePunishment(a.PunishmentsID)
+	}
+	a.ReviewerUUID = reviewer.UUID
+	a.ReviewerName = reviewer.Name
+	a.ReviewNote = note
+	a.ReviewedAt = &now
+
+	c := *a
+	if p := m.punishment(a.PunishmentsID); p != nil {
+		c.Punishment = *p
+	}
+	return &c, nil
+}
+
+// SetReport - Set Report Data
+func (m *Memory) SetReport(from, to PlayerIdentity, server, message string) (Report, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	report := Report{
+		ID:                 m.id(),
+		Date:               time.Now().UTC(),
+		Message:            message,
+		Server:             server,
+		ReporterPlayerUUID: from.UUID,
+		ReporterPlayerName: from.Name,
+		TargetPlayerUUID:   to.UUID,
+		TargetPlayerName:   to.Name,
+	}
+	m.reports = append(m.reports, report)
+	return report, nil
+}
+
+// GetReports - Get Reports (newest first / all players if targetUUID is empty)
+func (m *Memory) GetReports(targetUUID string, limit int) ([]Report, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var reports []Report
+	for i := len(m.reports) - 1; i >= 0 && (limit <= 0 || len(reports) < limit); i-- {
+		if targetUUID == "" || m.reports[i].TargetPlayerUUID == targetUUID {
+			r := m.reports[i]
+			r.Evidence = m.evidenceOf(0, r.ID)
+			reports = append(reports, r)
+		}
+	}
+	return reports, nil
+}
+
+// evidenceOf - Evidence attached to punishment / report (ordered by date)
+func (m *Memory) evidenceOf(punishmentID, reportID uint) []Evidences {
+	var evidence []Evidences
+	for _, e := range m.evidence {
+		if (punishmentID != 0 && e.PunishmentsID == punishmentID) || (reportID != 0 && e.ReportID == reportID) {
+			evidence = append(evidence, e)
+		}
+	}
+	return evidence
+}
+
+// SetObjectStore - Set store of evidence blobs
+func (m *Memory) SetObjectStore(objects ObjectStore) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects = objects
+}
+
+// AddEvidence - Attach evidence (and blob) to punishment or report
+func (m *Memory) AddEvidence(e *Evidences, blob []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e.PunishmentsID != 0 {
+		if m.punishment(e.PunishmentsID) == nil {
+			return status.ErrPunishmentNotFound.Error
+		}
+	} else {
+		found := false
+		for _, r := range m.reports {
+			found = found || r.ID == e.ReportID
+		}
+		if !found {
+			return status.ErrReportNotFound.Error
+		}
+	}
+
+	if err := putEvidenceBlob(m.objects, e, blob); err != nil {
+		return err
+	}
+	e.ID = m.id()
+	m.evidence = append(m.evidence, *e)
+	return nil
+}
+
+// GetEvidenceBlob - Get evidence with blob (blob is nil if not attached)
+func (m *Memory) GetEvidenceBlob(id uint) (*Evidences, []byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, e := range m.evidence {
+		if e.ID == id {
+			blob, err := getEvidenceBlob(m.objects, &e)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &e, blob, nil
+		}
+	}
+	return nil, nil, status.ErrEvidenceNotFound.Error
+}
+
+// AddPlayerNote - Add staff note
+func (m *Memory) AddPlayerNote(n *PlayerNotes) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n.ID = m.id()
+	m.notes = append(m.notes, *n)
+	return nil
+}
+
+// GetPlayerNotes - Get staff notes of player (newest first)
+func (m *Memory) GetPlayerNotes(playerUUID string, includeExpired bool) ([]PlayerNotes, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	var notes []PlayerNotes
+	for i := len(m.notes) - 1; i >= 0; i-- {
+		n := m.notes[i]
+		if n.PlayerUUID == playerUUID && (includeExpired || activeNote(&n, now)) {
+			notes = append(notes, n)
+		}
+	}
+	return notes, nil
+}
+
+// CountPlayerNotes - Count active staff notes of player
+func (m *Memory) CountPlayerNotes(playerUUID string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	var count int64
+	for i := range m.notes {
+		if m.notes[i].PlayerUUID == playerUUID && activeNote(&m.notes[i], now) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// DeletePlayerNote - Delete staff note
+func (m *Memory) DeletePlayerNote(id uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.notes {
+		if m.notes[i].ID == id {
+			m.notes = append(m.notes[:i], m.notes[i+1:]...)
+			return nil
+		}
+	}
+	return status.ErrNoteNotFound.Error
+}
+
+// CreateDispatch - Record dispatched command
+func (m *Memory) CreateDispatch(d *Dispatches) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, v := range m.dispatches {
+		if v.UUID == d.UUID {
+			return errors.New("dispatch already exists")
+		}
+	}
+
+	d.ID = m.id()
+	for i := range d.Results {
+		d.Results[i].ID = m.id()
+		d.Results[i].DispatchesID = d.ID
+	}
+	m.dispatches = append(m.dispatches, copyDispatch(d))
+	return nil
+}
+
+// copyDispatch - Copy dispatch (slices are not shared with caller)
+func copyDispatch(d *Dispatches) Dispatches {
+	c := *d
+	c.Results = append([]DispatchResults(nil), d.Results...)
+	return c
+}
+
+// AddDispatchResult - Record (or overwrite) result reported by server
+func (m *Memory) AddDispatchResult(id, serverName string, success bool, output string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.dispatches {
+		d := &m.dispatches[i]
+		if d.UUID != id {
+			continue
+		}
+
+		result := DispatchResults{
+			DispatchesID: d.ID,
+			ServerName:   serverName,
+			Success:      success,
+			Output:       output,
+			Date:         time.Now(),
+		}
+		for j := range d.Results {
+			if d.Results[j].ServerName == serverName {
+				result.ID = d.Results[j].ID
+				d.Results[j] = result
+				return nil
+			}
+		}
+		result.ID = m.id()
+		d.Results = append(d.Results, result)
+		return nil
+	}
+	return gorm.ErrRecordNotFound
+}
+
+// GetDispatch - Get dispatched command with results
+func (m *Memory) GetDispatch(id string) (Dispatches, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.dispatches {
+		if m.dispatches[i].UUID == id {
+			return copyDispatch(&m.dispatches[i]), nil
+		}
+	}
+	return Dispatches{}, gorm.ErrRecordNotFound
+}
+
+// GetDispatchHistory - Get dispatched commands (newest first / all targets if target is empty)
+func (m *Memory) GetDispatchHistory(target string, limit int) ([]Dispatches, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var history []Dispatches
+	for i := range m.dispatches {
+		if target == "" || m.dispatches[i].Target == target {
+			history = append(history, copyDispatch(&m.dispatches[i]))
+		}
+	}
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].Date.After(history[j].Date)
+	})
+	if limit > 0 && len(history) > limit {
+		history = history[:limit]
+	}
+	return history, nil
+}
+
+// copyAnnouncement - Copy announcement (slices are not shared with caller)
+func copyAnnouncement(a *ScheduledAnnouncements) ScheduledAnnouncements {
+	c := *a
+	c.Messages = append([]ScheduledAnnouncementMessages(nil), a.Messages...)
+	return c
+}
+
+// CreateScheduledAnnouncement - Create scheduled announcement
+func (m *Memory) CreateScheduledAnnouncement(a *ScheduledAnnouncements) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	a.ID = m.id()
+	for i := range a.Messages {
+		a.Messages[i].ID = m.id()
+		a.Messages[i].ScheduledAnnouncementsID = a.ID
+		a.Messages[i].Position = i
+	}
+	m.announcements = append(m.announcements, copyAnnouncement(a))
+	return nil
+}
+
+// GetScheduledAnnouncements - Get scheduled announcements (ordered by next run)
+func (m *Memory) GetScheduledAnnouncements(includeFinished bool) ([]ScheduledAnnouncements, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var entries []ScheduledAnnouncements
+	for i := range m.announcements {
+		if includeFinished || m.announcements[i].NextRun != nil {
+			entries = append(entries, copyAnnouncement(&m.announcements[i]))
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i].NextRun, entries[j].NextRun
+		if (a == nil) != (b == nil) {
+			return b == nil
+		}
+		if a != nil && !a.Equal(*b) {
+			return a.Before(*b)
+		}
+		return entries[i].ID < entries[j].ID
+	})
+	return entries, nil
+}
+
+// DeleteScheduledAnnouncement - Delete scheduled announcement
+func (m *Memory) DeleteScheduledAnnouncement(id uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.announcements {
+		if m.announcements[i].ID == id {
+			m.announcements = append(m.announcements[:i], m.announcements[i+1:]...)
+			return nil
+		}
+	}
+	return status.ErrAnnouncementNotFound.Error
+}
+
+// ClaimDueAnnouncements - Reschedule announcements due at now and return them for publishing
+// next returns the next run (nil: finished). Returned entries keep the rotation of this run.
+func (m *Memory) ClaimDueAnnouncements(now time.Time, limit int, next func(a *ScheduledAnnouncements) *time.Time) ([]ScheduledAnnouncements, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var due []*ScheduledAnnouncements
+	for i := range m.announcements {
+		a := &m.announcements[i]
+		if a.NextRun != nil && !a.NextRun.After(now) {
+			due = append(due, a)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].NextRun.Before(*due[j].NextRun)
+	})
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+
+	var claimed []ScheduledAnnouncements
+	for _, a := range due {
+		c := copyAnnouncement(a)
+		claimed = append(claimed, c)
+
+		last := now
+		a.NextRun = next(&c)
+		a.LastRun = &last
+		a.Rotation++
+	}
+	return claimed, nil
+}
+
+// ServerHeartbeat - Register or renew server (returns true if server came up)
+func (m *Memory) ServerHeartbeat(server *Servers) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	server.Online = true
+	current, ok := m.servers[server.Name]
+	if !ok {
+		server.ID = m.id()
+		c := *server
+		m.servers[server.Name] = &c
+		return true, nil
+	}
+
+	up := !current.Online
+	server.ID = current.ID
+	*current = *server
+	return up, nil
+}
+
+// UnregisterServer - Mark server down (returns false if already down)
+func (m *Memory) UnregisterServer(name string) (Servers, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	server, ok := m.servers[name]
+	if !ok || !server.Online {
+		return Servers{}, false, nil
+	}
+	server.Online = false
+	return *server, true, nil
+}
+
+// ExpireServers - Mark servers without heartbeat until now as down (returns expired servers)
+func (m *Memory) ExpireServers(now time.Time) ([]Servers, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var expired []Servers
+	for _, server := range m.servers {
+		if server.Online && server.ExpiresAt.Before(now) {
+			server.Online = false
+			expired = append(expired, *server)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].Name < expired[j].Name
+	})
+	return expired, nil
+}
+
+// GetServer - Get registered server
+func (m *Memory) GetServer(name string) (Servers, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	server, ok := m.servers[name]
+	if !ok {
+		return Servers{}, gorm.ErrRecordNotFound
+	}
+	return *server, nil
+}
+
+// GetServers - Get registered servers
+func (m *Memory) GetServers(includeOffline bool) ([]Servers, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var servers []Servers
+	for _, server := range m.servers {
+		if includeOffline || server.Online {
+			servers = append(servers, *server)
+		}
+	}
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].Name < servers[j].Name
+	})
+	return servers, nil
+}
+
+// copyServerGroup - Copy server group (slices are not shared with caller)
+func copyServerGroup(g *ServerGroups) ServerGroups {
+	c := *g
+	c.Members = append([]ServerGroupMembers(nil), g.Members...)
+	return c
+}
+
+// GetServerGroup - Get server group (gorm.ErrRecordNotFound if not exists)
+func (m *Memory) GetServerGroup(name string) (ServerGroups, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.serverGroups {
+		if m.serverGroups[i].Name == name {
+			return copyServerGroup(&m.serverGroups[i]), nil
+		}
+	}
+	return ServerGroups{}, gorm.ErrRecordNotFound
+}
+
+// GetAllServerGroups - Get all server groups
+func (m *Memory) GetAllServerGroups() ([]ServerGroups, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var groups []ServerGroups
+	for i := range m.serverGroups {
+		groups = append(groups, copyServerGroup(&m.serverGroups[i]))
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+	return groups, nil
+}
+
+// SetServerGroup - Create or replace server group
+func (m *Memory) SetServerGroup(name string, servers []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var group *ServerGroups
+	for i := range m.serverGroups {
+		if m.serverGroups[i].Name == name {
+			group = &m.serverGroups[i]
+		}
+	}
+	if group == nil {
+		m.serverGroups = append(m.serverGroups, ServerGroups{ID: m.id(), Name: name})
+		group = &m.serverGroups[len(m.serverGroups)-1]
+	}
+
+	group.Members = nil
+	seen := make(map[string]bool)
+	for _, server := range servers {
+		if seen[server] {
+			continue
+		}
+		seen[server] = true
+		group.Members = append(group.Members, ServerGroupMembers{
+			ID:             m.id(),
+			ServerGroupsID: group.ID,
+			ServerName:     server,
+		})
+	}
+	return nil
+}
+
+// RemoveServerGroup - Remove server group
+func (m *Memory) RemoveServerGroup(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.serverGroups {
+		if m.serverGroups[i].Name == name {
+			m.serverGroups = append(m.serverGroups[:i], m.serverGroups[i+1:]...)
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+// openSession - Start session on server
+func (m *Memory) openSession(uuid, server string, at time.Time) {
+	m.sessions = append(m.sessions, PlayerSessions{
+		ID:         m.id(),
+		PlayerUUID: uuid,
+		ServerName: server,
+		JoinedAt:   at,
+	})
+}
+
+// closeSessions - Finish open sessions of player
+func (m *Memory) closeSessions(uuid string, at time.Time) {
+	for i := range m.sessions {
+		s := &m.sessions[i]
+		if s.PlayerUUID != uuid || s.LeftAt != nil {
+			continue
+		}
+
+		left := at
+		s.LeftAt = &left
+		s.Duration = openDuration(s.JoinedAt, at)
+	}
+}
+
+// sessionEnd - End of open session of player (capped at lapsed presence lease)
+func (m *Memory) sessionEnd(uuid string, now time.Time) time.Time {
+	if p, ok := m.players[uuid]; ok {
+		return sessionEnd(p.PresenceExpiresAt, now)
+	}
+	return now
+}
+
+// GetPlaytime - Playtime per server (including current session)
+func (m *Memory) GetPlaytime(uuid string) (map[string]int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	end := m.sessionEnd(uuid, time.Now())
+	playtime := make(map[string]int64)
+	for _, s := range m.sessions {
+		if s.PlayerUUID != uuid {
+			continue
+		}
+		if s.LeftAt != nil {
+			playtime[s.ServerName] += s.Duration
+		} else {
+			playtime[s.ServerName] += openDuration(s.JoinedAt, end)
+		}
+	}
+	return playtime, nil
+}
+
+// GetSessions - Get sessions of player (newest first / all servers if server is empty)
+func (m *Memory) GetSessions(uuid, server string, limit int) ([]PlayerSessions, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	end := m.sessionEnd(uuid, time.Now())
+	var sessions []PlayerSessions
+	for _, s := range m.sessions {
+		if s.PlayerUUID == uuid && (server == "" || s.ServerName == server) {
+			if s.LeftAt == nil {
+				s.Duration = openDuration(s.JoinedAt, end)
+			}
+			sessions = append(sessions, s)
+		}
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].JoinedAt.After(sessions[j].JoinedAt)
+	})
+	if limit > 0 && len(sessions) > limit {
+		sessions = sessions[:limit]
+	}
+	return sessions, nil
+}
+
+// GetPlaytimeLeaderboard - Players ordered by playtime of finished sessions
+func (m *Memory) GetPlaytimeLeaderboard(server string, since time.Time, limit int) ([]PlaytimeEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	totals := make(map[string]int64)
+	for _, s := range m.sessions {
+		if s.LeftAt == nil || (server != "" && s.ServerName != server) {
+			continue
+		}
+		if !since.IsZero() && s.JoinedAt.Before(since) {
+			continue
+		}
+		totals[s.PlayerUUID] += s.Duration
+	}
+
+	var entries []PlaytimeEntry
+	for uuid, playtime := range totals {
+		e := PlaytimeEntry{PlayerUUID: uuid, Playtime: playtime}
+		if p, ok := m.players[uuid]; ok {
+			e.Name = p.Name
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Playtime != entries[j].Playtime {
+			return entries[i].Playtime > entries[j].Playtime
+		}
+		return entries[i].PlayerUUID < entries[j].PlayerUUID
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries, nil
+}
@@ -0,0 +1,402 @@
+package database
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// migrationLockID - Advisory lock key (PostgreSQL)
+const migrationLockID = 7305
+
+// Migration - Numbered schema change (recorded in schema_migrations once applied)
+// Applied migrations must not be edited: their checksum is verified on every start.
+type Migration struct {
+	Version int
+	Name    string
+	Up      []Step
+	Down    []Step // nil: irreversible
+}
+
+// Step - Part of migration (Describe is hashed into the checksum)
+type Step struct {
+	Describe string
+	Apply    func(tx *gorm.DB) error
+}
+
+// SchemaMigrations - Applied migration
+type SchemaMigrations struct {
+	Version   int `gorm:"primary_key;autoIncrement:false;"`
+	Name      string
+	Checksum  string `gorm:"size:64;"`
+	AppliedAt time.Time
+}
+
+// MigrationStatus - Migration known to this binary and/or recorded in database
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+	Modified  bool // applied checksum differs from this binary
+	Unknown   bool // applied but not known to this binary (newer binary migrated)
+}
+
+// Checksum - SHA-256 of version, name and steps
+func (m *Migration) Checksum() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d %s\n", m.Version, m.Name)
+	for _, step := range m.Up {
+		fmt.Fprintf(h, "up: %s\n", step.Describe)
+	}
+	for _, step := range m.Down {
+		fmt.Fprintf(h, "down: %s\n", step.Describe)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// createTables - Create tables (AutoMigrate, so schemas created before migrations are adopted)
+func createTables(models ...interface{}) Step {
+	var desc []string
+	for _, model := range models {
+		desc = append(desc, describeModel(model))
+	}
+
+	return Step{
+		Describe: "create tables " + strings.Join(desc, ", "),
+		Apply: func(tx *gorm.DB) error {
+			return tx.Migrator().AutoMigrate(models...)
+		},
+	}
+}
+
+// dropTables - Drop tables (in reverse order)
+func dropTables(models ...interface{}) Step {
+	var desc []string
+	for _, model := range models {
+		desc = append(desc, describeModel(model))
+	}
+
+	return Step{
+		Describe: "drop tables " + strings.Join(desc, ", "),
+		Apply: func(tx *gorm.DB) error {
+			for i := len(models) - 1; i >= 0; i-- {
+				if err := tx.Migrator().DropTable(models[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// addColumns - Add missing columns and indexes of partial models to existing tables
+func addColumns(models ...interface{}) Step {
+	var desc []string
+	for _, model := range models {
+		desc = append(desc, describeModel(model))
+	}
+
+	return Step{
+		Describe: "add columns " + strings.Join(desc, ", "),
+		Apply: func(tx *gorm.DB) error {
+			return tx.Migrator().AutoMigrate(models...)
+		},
+	}
+}
+
+// dropColumns - Drop columns (fields) of model
+func dropColumns(model interface{}, fields ...string) Step {
+	return Step{
+		Describe: fmt.Sprintf("drop columns %s(%s)", describeModel(model), strings.Join(fields, ",")),
+		Apply: func(tx *gorm.DB) error {
+			for _, field := range fields {
+				if !tx.Migrator().HasColumn(model, field) {
+					continue
+				}
+				if err := tx.Migrator().DropColumn(model, field); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// dropUnique - Drop column-level UNIQUE created by `unique` tag
+// MySQL names it after the column and PostgreSQL <table>_<column>_key;
+// SQLite can't drop it, so the table is rebuilt with field of model (indexes must be recreated).
+func dropUnique(model interface{}, table, column, field string) Step {
+	return Step{
+		Describe: fmt.Sprintf("drop unique %s(%s)", table, column),
+		Apply: func(tx *gorm.DB) error {
+			switch tx.Dialector.Name() {
+			case "sqlite":
+				return tx.Migrator().AlterColumn(model, field)
+			case "postgres":
+				return tx.Exec(fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s_%s_key", table, table, column)).Error
+			default:
+				return dropIndexes(table, column).Apply(tx)
+			}
+		},
+	}
+}
+
+// dropIndexes - Drop indexes of table if exists
+func dropIndexes(table string, names ...string) Step {
+	return Step{
+		Describe: fmt.Sprintf("drop indexes %s(%s)", table, strings.Join(names, ",")),
+		Apply: func(tx *gorm.DB) error {
+			for _, name := range names {
+				if !tx.Migrator().HasIndex(table, name) {
+					continue
+				}
+				logrus.Infof("[Migrate] Dropping index %s.%s", table, name)
+				if err := tx.Migrator().DropIndex(table, name); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// execSQL - Run statement
+func execSQL(query string) Step {
+	return Step{
+		Describe: "exec " + query,
+		Apply: func(tx *gorm.DB) error {
+			return tx.Exec(query).Error
+		},
+	}
+}
+
+// describeModel - Name, fields and tags of model (snapshot changes alter the checksum)
+func describeModel(model interface{}) string {
+	t := reflect.Indirect(reflect.ValueOf(model)).Type()
+
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fields = append(fields, fmt.Sprintf("%s %s %q", f.Name, f.Type, f.Tag))
+	}
+	return fmt.Sprintf("%s{%s}", t.Name(), strings.Join(fields, "; "))
+}
+
+// appliedMigrations - Applied migrations by version
+// Read only: missing schema_migrations table means nothing is applied yet.
+func (s *Mysql) appliedMigrations(tx *gorm.DB) (map[int]SchemaMigrations, error) {
+	if !tx.Migrator().HasTable(&SchemaMigrations{}) {
+		return map[int]SchemaMigrations{}, nil
+	}
+
+	var rows []SchemaMigrations
+	if r := tx.Order("version").Find(&rows); r.Error != nil {
+		return nil, r.Error
+	}
+
+	applied := make(map[int]SchemaMigrations)
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+// withMigrationLock - Run fc holding lock, so replicas don't migrate concurrently
+func (s *Mysql) withMigrationLock(fc func(tx *gorm.DB) error) error {
+	switch s.client.Dialector.Name() {
+	case "mysql", "postgres":
+	default:
+		return fc(s.client)
+	}
+
+	// Locks are held by the session, so stay on one connection
+	return s.client.Connection(func(conn *gorm.DB) error {
+		if conn.Dialector.Name() == "postgres" {
+			if err := conn.Exec("SELECT pg_advisory_lock(?)", migrationLockID).Error; err != nil {
+				return err
+			}
+			defer conn.Exec("SELECT pg_advisory_unlock(?)", migrationLockID)
+		} else {
+			var locked int
+			if r := conn.Raw("SELECT GET_LOCK(?, ?)", "systera_migrate", 60).Scan(&locked); r.Error != nil {
+				return r.Error
+			}
+			if locked != 1 {
+				return fmt.Errorf("timed out waiting for migration lock")
+			}
+			defer conn.Exec("SELECT RELEASE_LOCK(?)", "systera_migrate")
+		}
+
+		return fc(conn)
+	})
+}
+
+// MigrationStatus - Status of all migrations (ordered by version)
+func (s *Mysql) MigrationStatus() ([]MigrationStatus, error) {
+	applied, err := s.appliedMigrations(s.client)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Migrate] Failed get applied migrations")
+		return nil, err
+	}
+
+	var result []MigrationStatus
+	for _, m := range migrations {
+		st := MigrationStatus{
+			Version: m.Version,
+			Name:    m.Name,
+		}
+		if row, ok := applied[m.Version]; ok {
+			st.Applied = true
+			st.AppliedAt = row.AppliedAt
+			st.Modified = row.Checksum != m.Checksum()
+			delete(applied, m.Version)
+		}
+		result = append(result, st)
+	}
+	for _, row := range applied {
+		result = append(result, MigrationStatus{
+			Version:   row.Version,
+			Name:      row.Name,
+			Applied:   true,
+			AppliedAt: row.AppliedAt,
+			Unknown:   true,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+	return result, nil
+}
+
+// CheckSchema - Return error if migrations are pending or applied migrations were modified
+func (s *Mysql) CheckSchema() error {
+	status, err := s.MigrationStatus()
+	if err != nil {
+		return err
+	}
+
+	var pending []string
+	for _, st := range status {
+		switch {
+		case st.Modified:
+			return fmt.Errorf("migration %d (%s) was modified after it was applied", st.Version, st.Name)
+		case st.Unknown:
+			logrus.Warnf("[Migrate] Schema is ahead of this binary (migration %d: %s)", st.Version, st.Name)
+		case !st.Applied:
+			pending = append(pending, fmt.Sprintf("%d", st.Version))
+		}
+	}
+	if len(pending) != 0 {
+		return fmt.Errorf("schema is behind, pending migrations: %s (run `systera migrate up`)", strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// MigrateUp - Apply pending migrations up to target version (0: latest), returns applied count
+func (s *Mysql) MigrateUp(target int) (int, error) {
+	count := 0
+	err := s.withMigrationLock(func(db *gorm.DB) error {
+		if err := db.Migrator().AutoMigrate(&SchemaMigrations{}); err != nil {
+			return err
+		}
+
+		applied, err := s.appliedMigrations(db)
+		if err != nil {
+			return err
+		}
+
+		for i := range migrations {
+			m := &migrations[i]
+			if target > 0 && m.Version > target {
+				break
+			}
+			if row, ok := applied[m.Version]; ok {
+				if row.Checksum != m.Checksum() {
+					return fmt.Errorf("migration %d (%s) was modified after it was applied", m.Version, m.Name)
+				}
+				continue
+			}
+
+			logrus.Infof("[Migrate] Applying %d: %s", m.Version, m.Name)
+			err := db.Transaction(func(tx *gorm.DB) error {
+				for _, step := range m.Up {
+					if err := step.Apply(tx); err != nil {
+						return err
+					}
+				}
+				return tx.Create(&SchemaMigrations{
+					Version:   m.Version,
+					Name:      m.Name,
+					Checksum:  m.Checksum(),
+					AppliedAt: time.Now(),
+				}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d (%s): %w", m.Version, m.Name, err)
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[Migrate] Failed MigrateUp")
+	}
+	return count, err
+}
+
+// MigrateDown - Revert latest applied migrations, returns reverted count
+func (s *Mysql) MigrateDown(steps int) (int, error) {
+	count := 0
+	err := s.withMigrationLock(func(db *gorm.DB) error {
+		applied, err := s.appliedMigrations(db)
+		if err != nil {
+			return err
+		}
+
+		// Check every migration to revert first, so nothing is reverted if one is irreversible
+		var revert []*Migration
+		for i := len(migrations) - 1; i >= 0 && len(revert) < steps; i-- {
+			m := &migrations[i]
+			row, ok := applied[m.Version]
+			if !ok {
+				continue
+			}
+			if row.Checksum != m.Checksum() {
+				return fmt.Errorf("migration %d (%s) was modified after it was applied", m.Version, m.Name)
+			}
+			if m.Down == nil {
+				return fmt.Errorf("migration %d (%s) is irreversible", m.Version, m.Name)
+			}
+			revert = append(revert, m)
+		}
+
+		for _, m := range revert {
+			logrus.Infof("[Migrate] Reverting %d: %s", m.Version, m.Name)
+			err := db.Transaction(func(tx *gorm.DB) error {
+				for _, step := range m.Down {
+					if err := step.Apply(tx); err != nil {
+						return err
+					}
+				}
+				return tx.Delete(&SchemaMigrations{}, "version = ?", m.Version).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d (%s): %w", m.Version, m.Name, err)
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[Migrate] Failed MigrateDown")
+	}
+	return count, err
+}
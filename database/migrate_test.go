@@ -0,0 +1,149 @@
+package database
+
+import (
+	"testing"
+	"time"
+)
+
+// pendingVersions - Versions not applied yet
+func pendingVersions(t *testing.T, db *Mysql) []int {
+	t.Helper()
+
+	status, err := db.MigrationStatus()
+	if err != nil {
+		t.Fatalf("MigrationStatus: %s", err)
+	}
+
+	var pending []int
+	for _, st := range status {
+		if !st.Applied {
+			pending = append(pending, st.Version)
+		}
+	}
+	return pending
+}
+
+func TestMigrationStatusIsReadOnly(t *testing.T) {
+	db := openSQLite(t)
+
+	if pending := pendingVersions(t, db); len(pending) != len(migrations) {
+		t.Fatalf("pending on empty database: %v", pending)
+	}
+	if db.client.Migrator().HasTable(&SchemaMigrations{}) {
+		t.Fatal("MigrationStatus created schema_migrations")
+	}
+	if err := db.CheckSchema(); err == nil {
+		t.Fatal("CheckSchema: expected pending migrations error")
+	}
+	if db.client.Migrator().HasTable(&SchemaMigrations{}) {
+		t.Fatal("CheckSchema created schema_migrations")
+	}
+}
+
+func TestMigrateUpDown(t *testing.T) {
+	db := openSQLite(t)
+
+	n, err := db.MigrateUp(1)
+	if err != nil || n != 1 {
+		t.Fatalf("MigrateUp(1): %d %v", n, err)
+	}
+	if pending := pendingVersions(t, db); len(pending) != len(migrations)-1 || pending[0] != 2 {
+		t.Fatalf("pending after MigrateUp(1): %v", pending)
+	}
+
+	n, err = db.MigrateUp(0)
+	if err != nil || n != len(migrations)-1 {
+		t.Fatalf("MigrateUp(0): %d %v", n, err)
+	}
+	if err := db.CheckSchema(); err != nil {
+		t.Fatalf("CheckSchema: %s", err)
+	}
+	if n, _ := db.MigrateUp(0); n != 0 {
+		t.Fatalf("MigrateUp(0) again applied %d", n)
+	}
+
+	latest := migrations[len(migrations)-1].Version
+	n, err = db.MigrateDown(1)
+	if err != nil || n != 1 {
+		t.Fatalf("MigrateDown(1): %d %v", n, err)
+	}
+	if pending := pendingVersions(t, db); len(pending) != 1 || pending[0] != latest {
+		t.Fatalf("pending after MigrateDown(1): %v", pending)
+	}
+
+	// Reverted migration applies cleanly again
+	if n, err := db.MigrateUp(0); err != nil || n != 1 {
+		t.Fatalf("MigrateUp after down: %d %v", n, err)
+	}
+}
+
+func TestCheckSchemaModified(t *testing.T) {
+	db := openMigrated(t, openSQLite(t))
+
+	r := db.client.Model(&SchemaMigrations{}).Where("version = ?", 1).Update("checksum", "modified")
+	if r.Error != nil {
+		t.Fatal(r.Error)
+	}
+	if err := db.CheckSchema(); err == nil {
+		t.Fatal("CheckSchema: expected modified migration error")
+	}
+	if _, err := db.MigrateUp(0); err == nil {
+		t.Fatal("MigrateUp: expected modified migration error")
+	}
+}
+
+func TestMigrateDownIrreversible(t *testing.T) {
+	db := openMigrated(t, openSQLite(t))
+
+	// known_usernames_history can't be reverted: nothing is reverted
+	if n, err := db.MigrateDown(len(migrations)); err == nil || n != 0 {
+		t.Fatalf("MigrateDown(all): %d %v", n, err)
+	}
+	if pending := pendingVersions(t, db); len(pending) != 0 {
+		t.Fatalf("pending after refused MigrateDown: %v", pending)
+	}
+
+	// Migrations after it are still reversible
+	for _, m := range migrations {
+		if m.Down == nil {
+			if n, err := db.MigrateDown(len(migrations) - m.Version); err != nil || n != len(migrations)-m.Version {
+				t.Fatalf("MigrateDown to %d: %d %v", m.Version, n, err)
+			}
+		}
+	}
+}
+
+func TestMigrateNameHistory(t *testing.T) {
+	db := openSQLite(t)
+
+	// Database created before name history
+	if _, err := db.MigrateUp(7); err != nil {
+		t.Fatal(err)
+	}
+	lastUsed := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	insert := "INSERT INTO known_usernames (player_uuid, username, username_lower, last_used) VALUES (?, ?, ?, ?)"
+	if err := db.client.Exec(insert, "uuid-a", "Steve", "steve", lastUsed).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.client.Exec(insert, "uuid-b", "Steve", "steve", lastUsed).Error; err == nil {
+		t.Fatal("baseline: expected unique username")
+	}
+
+	if _, err := db.MigrateUp(0); err != nil {
+		t.Fatalf("MigrateUp: %s", err)
+	}
+	var firstSeen time.Time
+	if err := db.client.Raw("SELECT first_seen FROM known_usernames WHERE player_uuid = ?", "uuid-a").Scan(&firstSeen).Error; err != nil || !firstSeen.Equal(lastUsed) {
+		t.Fatalf("first_seen: %v %v", firstSeen, err)
+	}
+
+	// Same name is used by another player later
+	if err := db.client.Exec(insert, "uuid-b", "Steve", "steve", lastUsed).Error; err != nil {
+		t.Fatalf("insert used name: %s", err)
+	}
+	for _, index := range []string{"idx_known_usernames_player_uuid", "idx_known_usernames_username_lower"} {
+		if !db.client.Migrator().HasIndex("known_usernames", index) {
+			t.Errorf("index %s is missing", index)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package database
+
+import (
+	"time"
+)
+
+// migrations - Schema history (append only, ordered by version)
+// Models are snapshots as of the migration, so later model changes need a new migration.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "baseline",
+		Up:      []Step{createTables(baselineModels()...)},
+		Down:    []Step{dropTables(baselineModels()...)},
+	},
+	{
+		Version: 2,
+		Name:    "dispatches",
+		Up:      []Step{createTables(dispatchModels()...)},
+		Down:    []Step{dropTables(dispatchModels()...)},
+	},
+	{
+		Version: 3,
+		Name:    "scheduled_announcements",
+		Up:      []Step{createTables(announcementModels()...)},
+		Down:    []Step{dropTables(announcementModels()...)},
+	},
+	{
+		Version: 4,
+		Name:    "server_groups",
+		Up:      []Step{createTables(serverGroupModels()...)},
+		Down:    []Step{dropTables(serverGroupModels()...)},
+	},
+	{
+		Version: 5,
+		Name:    "servers",
+		Up:      []Step{createTables(serverModels()...)},
+		Down:    []Step{dropTables(serverModels()...)},
+	},
+	{
+		Version: 6,
+		Name:    "player_presence",
+		Up:      []Step{addColumns(presenceModels()...)},
+		Down: []Step{
+			dropIndexes("players", "idx_players_presence_expires_at"),
+			dropColumns(presenceModels()[0], "PresenceExpiresAt"),
+		},
+	},
+	{
+		Version: 7,
+		Name:    "player_sessions",
+		Up:      []Step{createTables(sessionModels()...)},
+		Down:    []Step{dropTables(sessionModels()...)},
+	},
+	{
+		Version: 8,
+		Name:    "known_usernames_history",
+		Up: []Step{
+			// Name history used to be overwritten
+			dropUnique(nameHistoryModels()[0], "known_usernames", "username", "Username"),
+			dropIndexes("known_usernames", "idx_known_usernames_username"),
+			addColumns(nameHistoryModels()...),
+			// Rows created before name history
+			execSQL("UPDATE known_usernames SET first_seen = last_used WHERE first_seen IS NULL"),
+		},
+		// Irreversible: names used by several players can't be unique again
+		Down: nil,
+	},
+	{
+		Version: 9,
+		Name:    "punishment_appeals",
+		Up:      []Step{createTables(appealModels()...)},
+		Down:    []Step{dropTables(appealModels()...)},
+	},
+	{
+		Version: 10,
+		Name:    "evidences",
+		Up:      []Step{createTables(evidenceModels()...)},
+		Down:    []Step{dropTables(evidenceModels()...)},
+	},
+	{
+		Version: 11,
+		Name:    "player_notes",
+		Up:      []Step{createTables(noteModels()...)},
+		Down:    []Step{dropTables(noteModels()...)},
+	},
+}
+
+// baselineModels - Schema created by AutoMigrate before versioned migrations
+func baselineModels() []interface{} {
+	type Permissions struct {
+		ID         uint   `gorm:"primary_key;AutoIncrement;"`
+		GroupsID   uint   `gorm:"foreign_key;index:perms_index,unique;"`
+		ServerName string `gorm:"primary_key;"`
+		Permission string `gorm:"index:perms_index,unique;"`
+	}
+	type Groups struct {
+		ID          uint   `gorm:"primary_key;AutoIncrement;"`
+		Name        string `gorm:"index;not null;"`
+		Prefix      string
+		Permissions []Permissions `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	}
+	type PlayerSettings struct {
+		PlayersID   uint `gorm:"foreign_key;unique;"`
+		JoinMessage bool
+		Vanish      bool
+		Japanize    bool
+		GlobalChat  bool
+	}
+	type IgnoreEntry struct {
+		PlayersID uint   `gorm:"foreign_key;index:idx_ignore,unique;"`
+		UUID      string `gorm:"foreign_key;index:idx_ignore,unique;"`
+		Name      string
+	}
+	type Players struct {
+		ID            uint   `gorm:"primary_key;AutoIncrement;"`
+		UUID          string `gorm:"index;unique;"`
+		Name          string `gorm:"index;not null;"`
+		NameLower     string
+		CurrentServer string
+		FirstLogin    time.Time `gorm:"type:datetime"`
+		LastLogin     time.Time `gorm:"type:datetime"`
+		Groups        string
+		Settings      PlayerSettings `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+		IgnoreList    []IgnoreEntry  `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	}
+	type PlayerAddresses struct {
+		ID         uint   `gorm:"primary_key;AutoIncrement;"`
+		PlayerUUID string `gorm:"index;foreign_key;"`
+		Address    string
+		Hostname   string
+		FirstSeen  time.Time `gorm:"type:datetime"`
+		LastSeen   time.Time `gorm:"type:datetime"`
+	}
+	type KnownUsernames struct {
+		ID            uint   `gorm:"primary_key;AutoIncrement;"`
+		PlayerUUID    string `gorm:"index;"`
+		Username      string `gorm:"unique;"`
+		UsernameLower string
+		LastUsed      time.Time
+	}
+	type Report struct {
+		ID                 uint      `gorm:"primary_key;AutoIncrement;"`
+		Date               time.Time `gorm:"type:datetime"`
+		Message            string
+		Server             string
+		ReporterPlayerUUID string `gorm:"index;"`
+		ReporterPlayerName string
+		TargetPlayerUUID   string `gorm:"index;"`
+		TargetPlayerName   string
+	}
+	type Punishments struct {
+		ID                 uint `gorm:"primary_key;AutoIncrement;"`
+		Available          bool
+		Level              int32 `gorm:"type:tinyint;"`
+		Reason             string
+		Date               time.Time `gorm:"type:datetime"`
+		Expire             time.Time `gorm:"type:datetime"`
+		PunisherPlayerUUID string
+		PunisherPlayerName string
+		TargetPlayerUUID   string `gorm:"index;"`
+		TargetPlayerName   string
+	}
+
+	return []interface{}{
+		&Groups{}, &Permissions{},
+		&Players{}, &PlayerAddresses{}, &PlayerSettings{}, &IgnoreEntry{}, &KnownUsernames{},
+		&Report{}, &Punishments{},
+	}
+}
+
+// dispatchModels - Dispatch history and results (version 2)
+func dispatchModels() []interface{} {
+	type DispatchResults struct {
+		ID           uint   `gorm:"primary_key;AutoIncrement;"`
+		DispatchesID uint   `gorm:"foreign_key;index:idx_dispatch_result,unique;"`
+		ServerName   string `gorm:"index:idx_dispatch_result,unique;"`
+		Success      bool
+		Output       string
+		Date         time.Time `gorm:"type:datetime"`
+	}
+	type Dispatches struct {
+		ID         uint   `gorm:"primary_key;AutoIncrement;"`
+		UUID       string `gorm:"index;unique;"`
+		Target     string `gorm:"index;"`
+		Command    string
+		IssuerUUID string
+		IssuerName string
+		Date       time.Time         `gorm:"type:datetime"`
+		Results    []DispatchResults `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	}
+
+	return []interface{}{&Dispatches{}, &DispatchResults{}}
+}
+
+// announcementModels - Scheduled announcements (version 3)
+func announcementModels() []interface{} {
+	type ScheduledAnnouncementMessages struct {
+		ID                       uint `gorm:"primary_key;AutoIncrement;"`
+		ScheduledAnnouncementsID uint `gorm:"foreign_key;index;"`
+		Position                 int
+		Message                  string `gorm:"type:text"`
+	}
+	type ScheduledAnnouncements struct {
+		ID         uint `gorm:"primary_key;AutoIncrement;"`
+		Target     string
+		Cron       string
+		NextRun    *time.Time `gorm:"type:datetime;index;"`
+		LastRun    *time.Time `gorm:"type:datetime"`
+		Rotation   int
+		AuthorUUID string
+		AuthorName string
+		CreatedAt  time.Time                       `gorm:"type:datetime"`
+		Messages   []ScheduledAnnouncementMessages `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	}
+
+	return []interface{}{&ScheduledAnnouncements{}, &ScheduledAnnouncementMessages{}}
+}
+
+// serverGroupModels - Named server groups (version 4)
+func serverGroupModels() []interface{} {
+	type ServerGroupMembers struct {
+		ID             uint   `gorm:"primary_key;AutoIncrement;"`
+		ServerGroupsID uint   `gorm:"foreign_key;index:idx_server_group_member,unique;"`
+		ServerName     string `gorm:"index:idx_server_group_member,unique;"`
+	}
+	type ServerGroups struct {
+		ID      uint                 `gorm:"primary_key;AutoIncrement;"`
+		Name    string               `gorm:"index;unique;not null;"`
+		Members []ServerGroupMembers `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	}
+
+	return []interface{}{&ServerGroups{}, &ServerGroupMembers{}}
+}
+
+// serverModels - Server registry (version 5)
+func serverModels() []interface{} {
+	type Servers struct {
+		ID            uint   `gorm:"primary_key;AutoIncrement;"`
+		Name          string `gorm:"index;unique;not null;"`
+		Address       string
+		Players       int32
+		MaxPlayers    int32
+		MOTD          string `gorm:"column:motd;type:text"`
+		State         string
+		Online        bool      `gorm:"index;"`
+		LastHeartbeat time.Time `gorm:"type:datetime"`
+		ExpiresAt     time.Time `gorm:"type:datetime;index;"`
+	}
+
+	return []interface{}{&Servers{}}
+}
+
+// presenceModels - Presence lease of players (version 6, columns added to players)
+func presenceModels() []interface{} {
+	type Players struct {
+		PresenceExpiresAt *time.Time `gorm:"type:datetime;index;"`
+	}
+
+	return []interface{}{&Players{}}
+}
+
+// sessionModels - Play sessions (version 7)
+func sessionModels() []interface{} {
+	type PlayerSessions struct {
+		ID         uint       `gorm:"primary_key;AutoIncrement;"`
+		PlayerUUID string     `gorm:"index;"`
+		ServerName string     `gorm:"index;"`
+		JoinedAt   time.Time  `gorm:"type:datetime;index;"`
+		LeftAt     *time.Time `gorm:"type:datetime;index;"`
+		Duration   int64
+	}
+
+	return []interface{}{&PlayerSessions{}}
+}
+
+// nameHistoryModels - Append-only name history (version 8, known_usernames without unique username)
+func nameHistoryModels() []interface{} {
+	type KnownUsernames struct {
+		ID            uint   `gorm:"primary_key;AutoIncrement;"`
+		PlayerUUID    string `gorm:"index;"`
+		Username      string
+		UsernameLower string `gorm:"index;"`
+		FirstSeen     time.Time
+		LastUsed      time.Time
+		ReleasedAt    *time.Time
+	}
+
+	return []interface{}{&KnownUsernames{}}
+}
+
+// appealModels - Punishment appeals (version 9)
+func appealModels() []interface{} {
+	type PunishmentAppeals struct {
+		ID            uint   `gorm:"primary_key;AutoIncrement;"`
+		PunishmentsID uint   `gorm:"index;"`
+		State         int32  `gorm:"type:tinyint;index;"`
+		Message       string `gorm:"type:text"`
+		PlayerUUID    string `gorm:"index;"`
+		PlayerName    string
+		Date          time.Time `gorm:"type:datetime"`
+		ReviewerUUID  string
+		ReviewerName  string
+		ReviewNote    string     `gorm:"type:text"`
+		ReviewedAt    *time.Time `gorm:"type:datetime"`
+	}
+
+	return []interface{}{&PunishmentAppeals{}}
+}
+
+// evidenceModels - Evidence of punishments / reports (version 10)
+func evidenceModels() []interface{} {
+	type Evidences struct {
+		ID            uint `gorm:"primary_key;AutoIncrement;"`
+		PunishmentsID uint `gorm:"index;"`
+		ReportID      uint `gorm:"index;"`
+		URL           string
+		Note          string `gorm:"type:text"`
+		UploaderUUID  string
+		UploaderName  string
+		Date          time.Time `gorm:"type:datetime"`
+		BlobKey       string
+		BlobSize      int64
+		ContentType   string
+	}
+
+	return []interface{}{&Evidences{}}
+}
+
+// noteModels - Staff notes on players (version 11)
+func noteModels() []interface{} {
+	type PlayerNotes struct {
+		ID         uint   `gorm:"primary_key;AutoIncrement;"`
+		PlayerUUID string `gorm:"index;"`
+		Message    string `gorm:"type:text"`
+		Severity   int32  `gorm:"type:tinyint;"`
+		AuthorUUID string
+		AuthorName string
+		CreatedAt  time.Time  `gorm:"type:datetime"`
+		ExpiresAt  *time.Time `gorm:"type:datetime;index;"`
+	}
+
+	return []interface{}{&PlayerNotes{}}
+}
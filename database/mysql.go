@@ -1,15 +1,47 @@
 package database
 
 import (
+	"strings"
+	"time"
+
 	"github.com/sirupsen/logrus"
 	"github.com/synchthia/systera-api/logger"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
+// Mysql - GORM implementation of Storage (MySQL / PostgreSQL / SQLite)
 type Mysql struct {
 	client   *gorm.DB
 	database string
+	resolver UUIDResolver
+	objects  ObjectStore
+}
+
+// NewClient - Connect to database selected by DSN scheme
+// postgres:// or postgresql:// (PostgreSQL), sqlite://path (SQLite), mysql:// or bare DSN (MySQL)
+func NewClient(dsn string) *Mysql {
+	switch DriverOf(dsn) {
+	case "postgres":
+		return NewPostgresClient(dsn)
+	case "sqlite":
+		return NewSQLiteClient(strings.TrimPrefix(dsn, "sqlite://"))
+	default:
+		return NewMysqlClient(strings.TrimPrefix(dsn, "mysql://"), "systera")
+	}
+}
+
+// DriverOf - Driver selected by DSN scheme (postgres, sqlite or mysql)
+func DriverOf(dsn string) string {
+	switch {
+	case strings.HasPrefix(dsn, "postgres://"), strings.HasPrefix(dsn, "postgresql://"):
+		return "postgres"
+	case strings.HasPrefix(dsn, "sqlite://"):
+		return "sqlite"
+	default:
+		return "mysql"
+	}
 }
 
 func NewMysqlClient(mysqlConStr, database string) *Mysql {
@@ -30,56 +62,42 @@ func NewMysqlClient(mysqlConStr, database string) *Mysql {
 		return nil
 	}
 
-	m := &Mysql{
-		client:   client,
-		database: database,
-	}
-
-	if err := m.client.AutoMigrate(&Groups{}); err != nil {
-		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
-		return nil
-	}
-
-	if err := m.client.AutoMigrate(&Permissions{}); err != nil {
-		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
-		return nil
-	}
-
-	if err := m.client.AutoMigrate(&Players{}); err != nil {
-		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
-		return nil
-	}
-
-	if err := m.client.AutoMigrate(&PlayerAddresses{}); err != nil {
-		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
-		return nil
-	}
+	return newClient(client, database)
+}
 
-	if err := m.client.AutoMigrate(&PlayerSettings{}); err != nil {
-		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
-		return nil
-	}
+// NewSQLiteClient - Open SQLite database (path or ":memory:" / requires cgo build)
+func NewSQLiteClient(path string) *Mysql {
+	logrus.WithField("path", path).Infof("[Database] Opening SQLite...")
 
-	if err := m.client.AutoMigrate(&IgnoreEntry{}); err != nil {
-		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
-		return nil
+	dsn := path
+	if dsn == ":memory:" {
+		// Share in-memory database between pooled connections
+		dsn = "file::memory:?cache=shared"
 	}
 
-	if err := m.client.AutoMigrate(&KnownUsernames{}); err != nil {
-		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+	client, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger: logger.NewGorm(),
+	})
+	if err != nil {
+		logrus.Fatalf("[Database] Failed to open SQLite: %s", err)
 		return nil
 	}
 
-	if err := m.client.AutoMigrate(&Report{}); err != nil {
-		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
-		return nil
-	}
+	return newClient(client, path)
+}
 
-	if err := m.client.AutoMigrate(&Punishments{}); err != nil {
-		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
-		return nil
+func newClient(client *gorm.DB, database string) *Mysql {
+	m := &Mysql{
+		client:   client,
+		database: database,
+		resolver: NewCachingResolver(NewMojangResolver(5*time.Second), time.Hour, 5*time.Minute),
 	}
-	logrus.Infof("[MySQL] Connected to MySQL")
+	logrus.Infof("[Database] Connected to %s", client.Dialector.Name())
 
 	return m
 }
+
+// SetUUIDResolver - Set resolver used by NameToUUID for unknown names
+func (s *Mysql) SetUUIDResolver(resolver UUIDResolver) {
+	s.resolver = resolver
+}
@@ -0,0 +1,116 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/status"
+	"github.com/synchthia/systera-api/systerapb"
+)
+
+// NoteSeverity - Severity of staff note
+type NoteSeverity int32
+
+const (
+	// NoteInfo - Information
+	NoteInfo NoteSeverity = iota
+
+	// NoteWatch - Watch this player
+	NoteWatch
+
+	// NoteAlert - Act on sight
+	NoteAlert
+)
+
+// PlayerNotes - Staff-only note on player
+type PlayerNotes struct {
+	ID         uint         `gorm:"primary_key;AutoIncrement;"`
+	PlayerUUID string       `gorm:"index;"`
+	Message    string       `gorm:"type:text"`
+	Severity   NoteSeverity `gorm:"type:tinyint;"`
+	AuthorUUID string
+	AuthorName string
+	CreatedAt  time.Time  `gorm:"type:datetime"`
+	ExpiresAt  *time.Time `gorm:"type:datetime;index;"` // nil: never expires
+}
+
+// ToProtobuf - Convert to Protobuf
+func (n *PlayerNotes) ToProtobuf() *systerapb.PlayerNote {
+	entry := &systerapb.PlayerNote{
+		Id:       uint32(n.ID),
+		Uuid:     n.PlayerUUID,
+		Message:  n.Message,
+		Severity: systerapb.NoteSeverity(n.Severity),
+		Author: &systerapb.PlayerIdentity{
+			Uuid: n.AuthorUUID,
+			Name: n.AuthorName,
+		},
+		CreatedAt: n.CreatedAt.UnixMilli(),
+	}
+	if n.ExpiresAt != nil {
+		entry.ExpiresAt = n.ExpiresAt.UnixMilli()
+	}
+	return entry
+}
+
+// activeNote - Not expired
+func activeNote(n *PlayerNotes, now time.Time) bool {
+	return n.ExpiresAt == nil || n.ExpiresAt.After(now)
+}
+
+// AddPlayerNote - Add staff note
+func (s *Mysql) AddPlayerNote(n *PlayerNotes) error {
+	if r := s.client.Create(n); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Note] Failed AddPlayerNote (%s)", n.PlayerUUID)
+		return r.Error
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id":       n.ID,
+		"uuid":     n.PlayerUUID,
+		"severity": n.Severity,
+	}).Infof("[Note] %s added note", n.AuthorName)
+	return nil
+}
+
+// GetPlayerNotes - Get staff notes of player (newest first)
+func (s *Mysql) GetPlayerNotes(playerUUID string, includeExpired bool) ([]PlayerNotes, error) {
+	var notes []PlayerNotes
+
+	q := s.client.Where("player_uuid = ?", playerUUID).Order("created_at DESC, id DESC")
+	if !includeExpired {
+		q = q.Where("expires_at IS NULL OR expires_at > ?", time.Now())
+	}
+
+	if r := q.Find(&notes); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Note] Failed GetPlayerNotes (%s)", playerUUID)
+		return nil, r.Error
+	}
+	return notes, nil
+}
+
+// CountPlayerNotes - Count active staff notes of player
+func (s *Mysql) CountPlayerNotes(playerUUID string) (int64, error) {
+	var count int64
+	r := s.client.Model(&PlayerNotes{}).
+		Where("player_uuid = ? AND (expires_at IS NULL OR expires_at > ?)", playerUUID, time.Now()).
+		Count(&count)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Note] Failed CountPlayerNotes (%s)", playerUUID)
+		return 0, r.Error
+	}
+	return count, nil
+}
+
+// DeletePlayerNote - Delete staff note
+func (s *Mysql) DeletePlayerNote(id uint) error {
+	r := s.client.Delete(&PlayerNotes{}, id)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Note] Failed DeletePlayerNote (%d)", id)
+		return r.Error
+	}
+	if r.RowsAffected == 0 {
+		return status.ErrNoteNotFound.Error
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/synchthia/systera-api/status"
+)
+
+func TestPlayerNotes(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		now := time.Now().Truncate(time.Second)
+		expired := now.Add(-time.Minute)
+		expires := now.Add(time.Hour)
+
+		notes := []*PlayerNotes{
+			{PlayerUUID: steveUUID, Message: "old alt", Severity: NoteInfo, CreatedAt: now.Add(-2 * time.Hour), ExpiresAt: &expired},
+			{PlayerUUID: steveUUID, Message: "watch chat", Severity: NoteWatch, CreatedAt: now.Add(-time.Hour)},
+			{PlayerUUID: steveUUID, Message: "x-ray suspect", Severity: NoteAlert, CreatedAt: now, ExpiresAt: &expires},
+			{PlayerUUID: alexUUID, Message: "friendly", CreatedAt: now},
+		}
+		for _, n := range notes {
+			n.AuthorUUID, n.AuthorName = alexUUID, "Alex"
+			if err := db.AddPlayerNote(n); err != nil {
+				t.Fatal(err)
+			}
+			if n.ID == 0 {
+				t.Fatalf("AddPlayerNote did not set id: %+v", n)
+			}
+		}
+
+		active, err := db.GetPlayerNotes(steveUUID, false)
+		if err != nil || len(active) != 2 || active[0].Message != "x-ray suspect" || active[1].Message != "watch chat" {
+			t.Fatalf("GetPlayerNotes: %+v %v", active, err)
+		}
+		all, _ := db.GetPlayerNotes(steveUUID, true)
+		if len(all) != 3 || all[2].Message != "old alt" {
+			t.Fatalf("GetPlayerNotes(includeExpired): %+v", all)
+		}
+		if count, err := db.CountPlayerNotes(steveUUID); err != nil || count != 2 {
+			t.Fatalf("CountPlayerNotes: %d %v", count, err)
+		}
+
+		if err := db.DeletePlayerNote(notes[1].ID); err != nil {
+			t.Fatal(err)
+		}
+		if count, _ := db.CountPlayerNotes(steveUUID); count != 1 {
+			t.Fatalf("CountPlayerNotes after delete: %d", count)
+		}
+		if err := db.DeletePlayerNote(notes[1].ID); !errors.Is(err, status.ErrNoteNotFound.Error) {
+			t.Fatalf("DeletePlayerNote(deleted): %v", err)
+		}
+	})
+}
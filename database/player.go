@@ -4,7 +4,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/minotar/minecraft"
 	"github.com/sirupsen/logrus"
 	"github.com/synchthia/systera-api/status"
 	"github.com/synchthia/systera-api/systerapb"
@@ -14,16 +13,17 @@ import (
 
 // PlayerData - PlayerProfile on Database
 type Players struct {
-	ID            uint   `gorm:"primary_key;AutoIncrement;"`
-	UUID          string `gorm:"index;unique;"`
-	Name          string `gorm:"index;not null;"`
-	NameLower     string
-	CurrentServer string
-	FirstLogin    time.Time `gorm:"type:datetime"`
-	LastLogin     time.Time `gorm:"type:datetime"`
-	Groups        string
-	Settings      PlayerSettings `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	IgnoreList    []IgnoreEntry  `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	ID                uint   `gorm:"primary_key;AutoIncrement;"`
+	UUID              string `gorm:"index;unique;"`
+	Name              string `gorm:"index;not null;"`
+	NameLower         string
+	CurrentServer     string
+	PresenceExpiresAt *time.Time `gorm:"type:datetime;index;"` // lease of CurrentServer
+	FirstLogin        time.Time  `gorm:"type:datetime"`
+	LastLogin         time.Time  `gorm:"type:datetime"`
+	Groups            string
+	Settings          PlayerSettings `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	IgnoreList        []IgnoreEntry  `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
 
 // ToProtobuf - Convert to Protobuf Entry
@@ -125,13 +125,12 @@ func (ie *IgnoreEntry) ToPlayerIdentity() *PlayerIdentity {
 	}
 }
 
-// NameToUUID - Get UUID from Player Name
+// NameToUUID - Get UUID from Player Name (UUIDResolver if not known)
 func (s *Mysql) NameToUUID(name string) (string, error) {
 	pi, err := s.GetIdentityByName(name)
-	if err != nil && err == gorm.ErrRecordNotFound {
-		uuid, err := NameToUUIDwithMojang(name)
-		return uuid, err
-	} else if err != nil && err != gorm.ErrRecordNotFound {
+	if err != nil && err == status.ErrPlayerNotFound.Error {
+		return s.resolver.ResolveUUID(name)
+	} else if err != nil {
 		logrus.WithError(err).Errorf("[Player] NTU: Failed Failed get profile %s", name)
 		return "", err
 	}
@@ -139,16 +138,6 @@ func (s *Mysql) NameToUUID(name string) (string, error) {
 	return pi.UUID, nil
 }
 
-// NameToUUIDwithMojang - Get UUID from Mojang API
-func NameToUUIDwithMojang(name string) (string, error) {
-	uuid, err := minecraft.NewMinecraft().GetUUID(name)
-	if err != nil {
-		return "", err
-	}
-
-	return uuid, nil
-}
-
 // FindPlayer - Find PlayerProfile
 func (s *Mysql) FindPlayer(uuid string) (Players, error) {
 	var player Players
@@ -163,7 +152,7 @@ func (s *Mysql) FindPlayer(uuid string) (Players, error) {
 // FindPlayerByName - Find PlayerProfile from Name
 func (s *Mysql) FindPlayerByName(name string) (Players, error) {
 	var player Players
-	r := s.client.Model(&Players{}).Preload("IgnoreList").Preload("Settings").First(&player, "name_lower = ?", strings.ToLower(name))
+	r := s.client.Model(&Players{}).Preload("IgnoreList").Preload("Settings").Order("last_login DESC").First(&player, "name_lower = ?", strings.ToLower(name))
 	if r.Error != nil {
 		logrus.WithError(r.Error).Errorf("[Player] FPBN: Failed Failed get profile %s", name)
 		return Players{}, r.Error
@@ -171,66 +160,117 @@ func (s *Mysql) FindPlayerByName(name string) (Players, error) {
 	return player, nil
 }
 
+// PlayerLogin - Result of InitPlayerProfile
+type PlayerLogin struct {
+	Player       Players
+	FirstJoin    bool
+	PreviousName string       // Name at previous login (empty on first join)
+	Ban          *Punishments // Active TEMPBAN / PERMBAN (nil if not banned)
+}
+
+// NameChanged - Name differs from previous login
+func (l *PlayerLogin) NameChanged() bool {
+	return !l.FirstJoin && l.PreviousName != l.Player.Name
+}
+
 // InitPlayerProfile - Initialize Player Profile
-func (s *Mysql) InitPlayerProfile(uuid, name, ipAddress, hostname string) (*Players, error) {
+// Profile, address / username log are updated in single transaction, and concurrent logins of same player are serialized.
+func (s *Mysql) InitPlayerProfile(uuid, name, ipAddress, hostname string) (*PlayerLogin, error) {
 	nowtime := time.Now()
+	login := &PlayerLogin{}
+
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		// Make sure row exists before locking (locking missing row takes gap lock)
+		r := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "uuid"}},
+			DoNothing: true,
+		}).Create(&Players{
+			UUID:       uuid,
+			Name:       name,
+			NameLower:  strings.ToLower(name),
+			FirstLogin: nowtime,
+			LastLogin:  nowtime,
+			Groups:     "default",
+		})
+		if r.Error != nil {
+			return r.Error
+		}
+		login.FirstJoin = r.RowsAffected != 0
 
-	var player Players
-	r := s.client.Preload("IgnoreList").Preload("Settings").First(&player, "uuid = ?", uuid)
-	if r.Error != nil && r.Error != gorm.ErrRecordNotFound {
-		logrus.WithError(r.Error).Errorf("[Player] IPP: Failed Failed get profile %s(%s)", name, uuid)
-		return &Players{}, r.Error
-	}
+		player := &login.Player
+		r = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(player, "uuid = ?", uuid)
+		if r.Error != nil {
+			return r.Error
+		}
 
-	if r.RowsAffected == 0 {
-		// Initialize
-		player.FirstLogin = nowtime
-		player.Settings = PlayerSettings{
-			JoinMessage: true,
-			Japanize:    true,
-			Vanish:      false,
-			GlobalChat:  true,
+		if login.FirstJoin {
+			// Initialize
+			r = tx.Create(&PlayerSettings{
+				PlayersID:   player.ID,
+				JoinMessage: true,
+				Japanize:    true,
+				Vanish:      false,
+				GlobalChat:  true,
+			})
+			if r.Error != nil {
+				return r.Error
+			}
+		} else {
+			login.PreviousName = player.Name
 		}
-	}
 
-	// User Profile
-	player.UUID = uuid
-	player.Name = name
-	player.NameLower = strings.ToLower(name)
-	player.LastLogin = nowtime
+		// User Profile
+		player.Name = name
+		player.NameLower = strings.ToLower(name)
+		player.LastLogin = nowtime
 
-	// Player Groups
-	if len(player.Groups) == 0 {
-		player.Groups = "default"
-	}
+		// Player Groups
+		if len(player.Groups) == 0 {
+			player.Groups = "default"
+		}
 
-	// Update address log
-	if err := s.UpdateKnownAddress(uuid, ipAddress, hostname); err != nil {
-		return nil, err
-	}
+		r = tx.Model(player).Updates(map[string]interface{}{
+			"name":       player.Name,
+			"name_lower": player.NameLower,
+			"last_login": player.LastLogin,
+			"groups":     player.Groups,
+		})
+		if r.Error != nil {
+			return r.Error
+		}
 
-	// Update username log
-	if err := s.UpdateKnownUsername(uuid, name); err != nil {
-		return nil, err
-	}
+		// Update address log
+		if err := updateKnownAddress(tx, uuid, ipAddress, hostname); err != nil {
+			return err
+		}
 
-	result := s.client.Preload("IgnoreList").Preload("Settings").Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "uuid"}},
-		UpdateAll: true,
-	}).Create(&player)
+		// Update username log
+		if err := updateKnownUsername(tx, uuid, name); err != nil {
+			return err
+		}
 
-	if result.Error != nil {
-		logrus.WithError(result.Error).Errorf("[Player] IPP: Failed to create profile %s(%s)", name, uuid)
-		return &Players{}, result.Error
+		ban, err := activeBan(tx, uuid, nowtime)
+		if err != nil {
+			return err
+		}
+		login.Ban = ban
+
+		return tx.Preload("IgnoreList").Preload("Settings").First(player, player.ID).Error
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[Player] IPP: Failed to init profile %s(%s)", name, uuid)
+		return nil, err
 	}
 
 	logrus.WithFields(logrus.Fields{
-		"name":    name,
-		"uuid":    uuid,
-		"address": ipAddress,
+		"name":      name,
+		"uuid":      uuid,
+		"address":   ipAddress,
+		"firstJoin": login.FirstJoin,
+		"banned":    login.Ban != nil,
 	}).Infof("[Player] InitPlayerProfile")
 
-	return &player, nil
+	return login, nil
 }
 
 // SetPlayerGroups - Define Player Groups
@@ -260,32 +300,61 @@ func (s *Mysql) SetPlayerGroups(uuid string, groups []string) error {
 	return nil
 }
 
-// SetPlayerServer - Define Player Current Server
+// SetPlayerServer - Define Player Current Server (and record session)
 func (s *Mysql) SetPlayerServer(isQuit bool, uuid, server string) error {
 	var player Players
-	r := s.client.Clauses(clause.Locking{Strength: "UPDATE"}).Model(&Players{}).First(&player, "uuid = ?", uuid)
-	if r.Error != nil {
-		logrus.WithError(r.Error).Errorf("[Player] SPServ: Failed Failed get profile (%s)", uuid)
-		return r.Error
-	}
+	var was string
+	skipped := false
+
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		r := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Model(&Players{}).First(&player, "uuid = ?", uuid)
+		if r.Error != nil {
+			logrus.WithError(r.Error).Errorf("[Player] SPServ: Failed Failed get profile (%s)", uuid)
+			return r.Error
+		}
 
-	was := player.CurrentServer
-	if isQuit {
-		if player.CurrentServer == server {
-			player.CurrentServer = ""
+		was = player.CurrentServer
+		if isQuit {
+			if player.CurrentServer == server {
+				player.CurrentServer = ""
+			} else {
+				logrus.Debugf("[Player] Skipped update server: %s -> %s > %s(%s)", player.CurrentServer, server, player.Name, uuid)
+				skipped = true
+				return nil
+			}
 		} else {
-			logrus.Debugf("[Player] Skipped update server: %s -> > %s(%s)", player.CurrentServer, server, player.Name, uuid)
-			return nil
+			player.CurrentServer = server
 		}
-	} else {
-		player.CurrentServer = server
-	}
 
-	result := s.client.Save(&player)
+		// Join starts lease, Heartbeat renews it
+		now := time.Now()
+		player.PresenceExpiresAt = nil
+		if player.CurrentServer != "" {
+			expires := now.Add(DefaultPresenceTTL)
+			player.PresenceExpiresAt = &expires
+		}
 
-	if result.Error != nil {
-		logrus.WithError(result.Error).Errorf("[Player] Failed Execute SetPlayerServer")
-		return result.Error
+		// Session
+		if was != player.CurrentServer {
+			if err := closeSessions(tx, []string{uuid}, now); err != nil {
+				return err
+			}
+			if player.CurrentServer != "" {
+				if err := openSession(tx, uuid, player.CurrentServer, now); err != nil {
+					return err
+				}
+			}
+		}
+
+		return tx.Save(&player).Error
+	})
+
+	if err != nil {
+		logrus.WithError(err).Errorf("[Player] Failed Execute SetPlayerServer")
+		return err
+	}
+	if skipped {
+		return nil
 	}
 
 	logrus.WithFields(logrus.Fields{
@@ -295,6 +364,29 @@ func (s *Mysql) SetPlayerServer(isQuit bool, uuid, server string) error {
 	return nil
 }
 
+// CountOnlinePlayers - Count players per current server
+func (s *Mysql) CountOnlinePlayers() (map[string]int64, error) {
+	var rows []struct {
+		CurrentServer string
+		Count         int64
+	}
+	r := s.client.Model(&Players{}).
+		Select("current_server, count(*) as count").
+		Where("current_server <> ''").
+		Group("current_server").
+		Scan(&rows)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Player] Failed CountOnlinePlayers")
+		return nil, r.Error
+	}
+
+	counts := make(map[string]int64)
+	for _, row := range rows {
+		counts[row.CurrentServer] = row.Count
+	}
+	return counts, nil
+}
+
 // SetPlayerSettings - Set Player Settings
 func (s *Mysql) SetPlayerSettings(uuid string, settings *PlayerSettings) error {
 	var player Players
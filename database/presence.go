@@ -0,0 +1,140 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultPresenceTTL - Presence lease set on join and by Heartbeat
+const DefaultPresenceTTL = 60 * time.Second
+
+// OnlinePlayer - Player with current server
+type OnlinePlayer struct {
+	UUID          string
+	Name          string
+	CurrentServer string
+}
+
+// RenewPresence - Renew presence lease of players on server
+// Players whose presence was cleared (ex. swept) are restored to server.
+func (s *Mysql) RenewPresence(server string, uuids []string, expires time.Time) (int64, error) {
+	if len(uuids) == 0 {
+		return 0, nil
+	}
+
+	var renewed int64
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		// Restored players start new session
+		var restored []string
+		r := tx.Model(&Players{}).Where("uuid IN ? AND current_server = ''", uuids).Pluck("uuid", &restored)
+		if r.Error != nil {
+			return r.Error
+		}
+		now := time.Now()
+		for _, uuid := range restored {
+			if err := openSession(tx, uuid, server, now); err != nil {
+				return err
+			}
+		}
+
+		r = tx.Model(&Players{}).
+			Where("uuid IN ? AND (current_server = ? OR current_server = '')", uuids, server).
+			Updates(map[string]interface{}{
+				"current_server":      server,
+				"presence_expires_at": expires,
+			})
+		renewed = r.RowsAffected
+		return r.Error
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[Presence] Failed RenewPresence (%s)", server)
+		return 0, err
+	}
+	return renewed, nil
+}
+
+// ExpirePresence - Clear current server of players whose lease lapsed before now (returns expired UUIDs)
+// Rows without lease (joined before leases existed) are expired too.
+func (s *Mysql) ExpirePresence(now time.Time) ([]string, error) {
+	var expired []string
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		var players []Players
+		r := tx.Model(&Players{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Select("uuid, presence_expires_at").
+			Where("current_server <> '' AND (presence_expires_at IS NULL OR presence_expires_at < ?)", now).
+			Find(&players)
+		if r.Error != nil || len(players) == 0 {
+			return r.Error
+		}
+
+		// Sessions end when lease lapsed, not when swept
+		for _, p := range players {
+			if err := closeSessions(tx, []string{p.UUID}, sessionEnd(p.PresenceExpiresAt, now)); err != nil {
+				return err
+			}
+			expired = append(expired, p.UUID)
+		}
+
+		return tx.Model(&Players{}).
+			Where("uuid IN ?", expired).
+			Updates(map[string]interface{}{
+				"current_server":      "",
+				"presence_expires_at": nil,
+			}).Error
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[Presence] Failed ExpirePresence")
+		return nil, err
+	}
+	return expired, nil
+}
+
+// ClearServerPresence - Clear current server of all players on server (server went down, returns cleared UUIDs)
+func (s *Mysql) ClearServerPresence(server string, at time.Time) ([]string, error) {
+	var cleared []string
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		r := tx.Model(&Players{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("current_server = ?", server).
+			Pluck("uuid", &cleared)
+		if r.Error != nil || len(cleared) == 0 {
+			return r.Error
+		}
+
+		if err := closeSessions(tx, cleared, at); err != nil {
+			return err
+		}
+
+		return tx.Model(&Players{}).
+			Where("uuid IN ? AND current_server = ?", cleared, server).
+			Updates(map[string]interface{}{
+				"current_server":      "",
+				"presence_expires_at": nil,
+			}).Error
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[Presence] Failed ClearServerPresence (%s)", server)
+		return nil, err
+	}
+	return cleared, nil
+}
+
+// GetOnlinePlayers - Get online players (all servers if server is empty)
+func (s *Mysql) GetOnlinePlayers(server string) ([]OnlinePlayer, error) {
+	var players []OnlinePlayer
+
+	q := s.client.Model(&Players{}).Select("uuid, name, current_server").Where("current_server <> ''").Order("name")
+	if server != "" {
+		q = q.Where("current_server = ?", server)
+	}
+
+	if r := q.Scan(&players); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Presence] Failed GetOnlinePlayers")
+		return nil, r.Error
+	}
+	return players, nil
+}
@@ -0,0 +1,205 @@
+package database
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/sirupsen/logrus"
+)
+
+// ProfileCache - Cache of encoded player profiles
+type ProfileCache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte)
+	Delete(keys ...string)
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// LRUProfileCache - In-process LRU cache (entries expire after ttl)
+type LRUProfileCache struct {
+	size int
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// NewLRUProfileCache - Create LRUProfileCache
+func NewLRUProfileCache(size int, ttl time.Duration) *LRUProfileCache {
+	return &LRUProfileCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get - Get entry (nil, false if not exists or expired)
+func (c *LRUProfileCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*lruEntry)
+	if time.Now().After(e.expires) {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return e.value, true
+}
+
+// Set - Set entry (least recently used entry is evicted when full)
+func (c *LRUProfileCache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := time.Now().Add(c.ttl)
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*lruEntry)
+		e.value, e.expires = value, expires
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value, expires: expires})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Delete - Delete entries
+func (c *LRUProfileCache) Delete(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if el, ok := c.entries[key]; ok {
+			c.order.Remove(el)
+			delete(c.entries, key)
+		}
+	}
+}
+
+// RedisProfileCache - Cache shared by replicas (keys: systera:profile:<key>)
+type RedisProfileCache struct {
+	pool *redis.Pool
+	ttl  time.Duration
+}
+
+// NewRedisProfileCache - Create RedisProfileCache
+func NewRedisProfileCache(server string, ttl time.Duration) *RedisProfileCache {
+	logrus.WithField("server", server).Infof("[Redis] Creating Pool...")
+
+	return &RedisProfileCache{
+		pool: &redis.Pool{
+			MaxIdle:     12,
+			IdleTimeout: 240 * time.Second,
+			Wait:        true,
+			Dial: func() (redis.Conn, error) {
+				// Cache errors are treated as miss, so fail fast instead of blocking lookups
+				return redis.Dial("tcp", server,
+					redis.DialConnectTimeout(time.Second),
+					redis.DialReadTimeout(500*time.Millisecond),
+					redis.DialWriteTimeout(500*time.Millisecond),
+				)
+			},
+		},
+		ttl: ttl,
+	}
+}
+
+// Get - Get entry (errors are treated as miss)
+func (c *RedisProfileCache) Get(key string) ([]byte, bool) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	value, err := redis.Bytes(conn.Do("GET", "systera:profile:"+key))
+	if err != nil {
+		if err != redis.ErrNil {
+			logrus.WithError(err).Warnf("[Cache] Failed to get profile (%s)", key)
+		}
+		return nil, false
+	}
+	return value, true
+}
+
+// Set - Set entry with ttl
+func (c *RedisProfileCache) Set(key string, value []byte) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("SET", "systera:profile:"+key, value, "PX", c.ttl.Milliseconds()); err != nil {
+		logrus.WithError(err).Warnf("[Cache] Failed to set profile (%s)", key)
+	}
+}
+
+// Delete - Delete entries
+func (c *RedisProfileCache) Delete(keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	args := make([]interface{}, len(keys))
+	for i, key := range keys {
+		args[i] = "systera:profile:" + key
+	}
+	if _, err := conn.Do("DEL", args...); err != nil {
+		logrus.WithError(err).Warnf("[Cache] Failed to delete profiles")
+	}
+}
+
+// TieredProfileCache - Local cache in front of shared cache
+type TieredProfileCache struct {
+	local  ProfileCache
+	shared ProfileCache
+}
+
+// NewTieredProfileCache - Create TieredProfileCache
+func NewTieredProfileCache(local, shared ProfileCache) *TieredProfileCache {
+	return &TieredProfileCache{
+		local:  local,
+		shared: shared,
+	}
+}
+
+// Get - Get from local, then shared cache (shared hits are stored locally)
+func (c *TieredProfileCache) Get(key string) ([]byte, bool) {
+	if value, ok := c.local.Get(key); ok {
+		return value, true
+	}
+	value, ok := c.shared.Get(key)
+	if ok {
+		c.local.Set(key, value)
+	}
+	return value, ok
+}
+
+// Set - Set to both caches
+func (c *TieredProfileCache) Set(key string, value []byte) {
+	c.local.Set(key, value)
+	c.shared.Set(key, value)
+}
+
+// Delete - Delete from both caches
+func (c *TieredProfileCache) Delete(keys ...string) {
+	c.local.Delete(keys...)
+	c.shared.Delete(keys...)
+}
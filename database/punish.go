@@ -29,6 +29,9 @@ const (
 	PERMBAN
 )
 
+// noExpiry - Expire before this never expires (stored as 0)
+var noExpiry = time.Unix(86400, 0)
+
 // PunishmentData - PunishData on Database
 type Punishments struct {
 	ID                 uint `gorm:"primary_key;AutoIncrement;"`
@@ -41,6 +44,7 @@ type Punishments struct {
 	PunisherPlayerName string
 	TargetPlayerUUID   string `gorm:"index;"`
 	TargetPlayerName   string
+	Evidence           []Evidences `gorm:"foreignKey:PunishmentsID;"`
 }
 
 // PunishRule - Validation Rules (true -> Permit)
@@ -54,6 +58,7 @@ type PunishRule struct {
 // ToProtobuf - Convert to Protobuf
 func (p *Punishments) ToProtobuf() *systerapb.PunishEntry {
 	return &systerapb.PunishEntry{
+		Id:        uint32(p.ID),
 		Available: p.Available,
 		Level:     p.Level.ToProtobuf(),
 		Reason:    p.Reason,
@@ -67,9 +72,15 @@ func (p *Punishments) ToProtobuf() *systerapb.PunishEntry {
 			Uuid: p.TargetPlayerUUID,
 			Name: p.TargetPlayerName,
 		},
+		Evidence: evidenceToProtobuf(p.Evidence),
 	}
 }
 
+// Permanent - Never expires
+func (p *Punishments) Permanent() bool {
+	return !p.Expire.After(noExpiry)
+}
+
 func (i PunishLevel) String() string {
 	switch i {
 	case WARN:
@@ -112,6 +123,7 @@ func (s *Mysql) GetPlayerPunishment(playerUUID string, filterLevel PunishLevel,
 	// - date: old_date -> now_date
 	if includeExpired {
 		r := s.client.Model(&Punishments{}).
+			Preload("Evidence", preloadEvidence).
 			Order("date ASC").
 			Find(&punishments, "target_player_uuid = ? AND level >= ?", playerUUID, filterLevel)
 		if r.Error != nil {
@@ -120,8 +132,9 @@ func (s *Mysql) GetPlayerPunishment(playerUUID string, filterLevel PunishLevel,
 		}
 	} else {
 		r := s.client.Model(&Punishments{}).
+			Preload("Evidence", preloadEvidence).
 			Order("date ASC").
-			Find(&punishments, "target_player_uuid = ? AND level >= ? AND available = true AND expire <= '1970-01-02' OR expire >= ?", playerUUID, filterLevel, nowtime)
+			Find(&punishments, "target_player_uuid = ? AND level >= ? AND available = true AND (expire <= ? OR expire >= ?)", playerUUID, filterLevel, noExpiry, nowtime)
 		if r.Error != nil {
 			logrus.WithError(r.Error).Errorf("[Punish] Failed GetPlayerPunishment(%s)", playerUUID)
 			return nil, r.Error
@@ -131,6 +144,22 @@ func (s *Mysql) GetPlayerPunishment(playerUUID string, filterLevel PunishLevel,
 	return punishments, nil
 }
 
+// activePunishment - Available and not expired (expire before 1970-01-02 means no expiry)
+func activePunishment(p *Punishments, now time.Time) bool {
+	return p.Available && (!p.Expire.After(noExpiry) || !p.Expire.Before(now))
+}
+
+// activeBan - Strongest active TEMPBAN / PERMBAN (nil if not banned)
+func activeBan(tx *gorm.DB, playerUUID string, now time.Time) (*Punishments, error) {
+	var ban Punishments
+	r := tx.Order("level DESC, date DESC").Limit(1).
+		Find(&ban, "target_player_uuid = ? AND level >= ? AND available = true AND (expire <= ? OR expire >= ?)", playerUUID, TEMPBAN, noExpiry, now)
+	if r.Error != nil || r.RowsAffected == 0 {
+		return nil, r.Error
+	}
+	return &ban, nil
+}
+
 // SetPlayerPunishment - Punish Player
 func (s *Mysql) SetPlayerPunishment(force bool, from, to PlayerIdentity, level PunishLevel, reason string, date, expire int64) (success bool, result PunishRule, err error) {
 	// Error Status
@@ -239,9 +268,21 @@ func (s *Mysql) UnBan(targetUUID string) error {
 	}
 
 	latest := p[len(p)-1]
-	latest.Available = false
+	return revokePunishment(s.client, &latest)
+}
 
-	// r := s.client.Model(&latest).Update("available", false)
-	r := s.client.Save(&latest)
-	return r.Error
+// revokePunishment - Disable punishment (UnBan / accepted appeal)
+func revokePunishment(tx *gorm.DB, p *Punishments) error {
+	p.Available = false
+
+	r := tx.Model(p).Update("available", false)
+	if r.Error != nil {
+		return r.Error
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id":    p.ID,
+		"level": p.Level,
+	}).Infof("[Punishment] Revoked punishment of %s", p.TargetPlayerName)
+	return nil
 }
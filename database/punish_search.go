@@ -0,0 +1,156 @@
+package database
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// PunishmentFilter - Conditions of SearchPunishments (zero values match all)
+type PunishmentFilter struct {
+	From       time.Time   // Date >= From
+	To         time.Time   // Date < To
+	Punisher   string      // UUID or name (case-insensitive)
+	Level      PunishLevel // exact level (UNKNOWN: any)
+	Keyword    string      // substring of Reason (case-insensitive)
+	ActiveOnly bool
+}
+
+// PunishmentCursor - Position after last entry of page (pages are ordered by date, newest first)
+type PunishmentCursor struct {
+	Date time.Time
+	ID   uint
+}
+
+// PunishmentPage - Page of SearchPunishments
+type PunishmentPage struct {
+	Punishments []Punishments
+	Next        *PunishmentCursor     // nil: last page
+	Totals      map[PunishLevel]int64 // matches of all pages per level (Level filter is ignored)
+}
+
+// match - Same conditions as query (Level is checked by caller)
+func (f *PunishmentFilter) match(p *Punishments, now time.Time) bool {
+	if !f.From.IsZero() && p.Date.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !p.Date.Before(f.To) {
+		return false
+	}
+	if f.Punisher != "" && p.PunisherPlayerUUID != f.Punisher && !strings.EqualFold(p.PunisherPlayerName, f.Punisher) {
+		return false
+	}
+	if f.Keyword != "" && !strings.Contains(strings.ToLower(p.Reason), strings.ToLower(f.Keyword)) {
+		return false
+	}
+	return !f.ActiveOnly || activePunishment(p, now)
+}
+
+// query - Apply filter to punishments query (except Level, shared with totals)
+func (f *PunishmentFilter) query(q *gorm.DB, now time.Time) *gorm.DB {
+	if !f.From.IsZero() {
+		q = q.Where("date >= ?", f.From)
+	}
+	if !f.To.IsZero() {
+		q = q.Where("date < ?", f.To)
+	}
+	if f.Punisher != "" {
+		q = q.Where("(punisher_player_uuid = ? OR LOWER(punisher_player_name) = ?)", f.Punisher, strings.ToLower(f.Punisher))
+	}
+	if f.Keyword != "" {
+		q = q.Where("LOWER(reason) LIKE ? ESCAPE '!'", "%"+likeEscaper.Replace(strings.ToLower(f.Keyword))+"%")
+	}
+	if f.ActiveOnly {
+		q = q.Where("available = true AND (expire <= ? OR expire >= ?)", noExpiry, now)
+	}
+	return q
+}
+
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// SearchPunishments - Search punishments of all players (newest first, page starts after cursor)
+func (s *Mysql) SearchPunishments(filter PunishmentFilter, after *PunishmentCursor, limit int) (PunishmentPage, error) {
+	now := time.Now()
+	page := PunishmentPage{Totals: make(map[PunishLevel]int64)}
+
+	q := filter.query(s.client.Model(&Punishments{}), now).Preload("Evidence", preloadEvidence)
+	if filter.Level != UNKNOWN {
+		q = q.Where("level = ?", filter.Level)
+	}
+	if after != nil {
+		q = q.Where("(date < ? OR (date = ? AND id < ?))", after.Date, after.Date, after.ID)
+	}
+
+	// One more entry to know whether next page exists
+	r := q.Order("date DESC, id DESC").Limit(limit + 1).Find(&page.Punishments)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Punish] Failed SearchPunishments")
+		return PunishmentPage{}, r.Error
+	}
+	if len(page.Punishments) > limit {
+		page.Punishments = page.Punishments[:limit]
+		last := page.Punishments[limit-1]
+		page.Next = &PunishmentCursor{Date: last.Date, ID: last.ID}
+	}
+
+	var totals []struct {
+		Level PunishLevel
+		Count int64
+	}
+	r = filter.query(s.client.Model(&Punishments{}), now).Select("level, COUNT(*) AS count").Group("level").Scan(&totals)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Punish] Failed SearchPunishments (totals)")
+		return PunishmentPage{}, r.Error
+	}
+	for _, t := range totals {
+		page.Totals[t.Level] = t.Count
+	}
+
+	return page, nil
+}
+
+// SearchPunishments - Search punishments of all players (same rules as Mysql)
+func (m *Memory) SearchPunishments(filter PunishmentFilter, after *PunishmentCursor, limit int) (PunishmentPage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	page := PunishmentPage{Totals: make(map[PunishLevel]int64)}
+
+	var matched []Punishments
+	for i := range m.punishments {
+		p := &m.punishments[i]
+		if !filter.match(p, now) {
+			continue
+		}
+		page.Totals[p.Level]++
+
+		if filter.Level != UNKNOWN && p.Level != filter.Level {
+			continue
+		}
+		if after != nil && !(p.Date.Before(after.Date) || (p.Date.Equal(after.Date) && p.ID < after.ID)) {
+			continue
+		}
+		matched = append(matched, *p)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].Date.Equal(matched[j].Date) {
+			return matched[i].Date.After(matched[j].Date)
+		}
+		return matched[i].ID > matched[j].ID
+	})
+	if len(matched) > limit {
+		matched = matched[:limit]
+		last := matched[limit-1]
+		page.Next = &PunishmentCursor{Date: last.Date, ID: last.ID}
+	}
+	for i := range matched {
+		matched[i].Evidence = m.evidenceOf(matched[i].ID, 0)
+	}
+	page.Punishments = matched
+	return page, nil
+}
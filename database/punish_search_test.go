@@ -0,0 +1,92 @@
+package database
+
+import (
+	"testing"
+	"time"
+)
+
+// searchAll - Follow cursors until last page, return reasons in page order
+func searchAll(t *testing.T, db Storage, filter PunishmentFilter, limit int) ([]string, PunishmentPage) {
+	t.Helper()
+
+	var reasons []string
+	var after *PunishmentCursor
+	for {
+		page, err := db.SearchPunishments(filter, after, limit)
+		if err != nil {
+			t.Fatalf("SearchPunishments: %s", err)
+		}
+		if len(page.Punishments) > limit {
+			t.Fatalf("page of %d entries exceeds limit %d", len(page.Punishments), limit)
+		}
+		for _, p := range page.Punishments {
+			reasons = append(reasons, p.Reason)
+		}
+		if page.Next == nil {
+			return reasons, page
+		}
+		after = page.Next
+	}
+}
+
+func TestSearchPunishments(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		mustInit(t, db, steveUUID, "Steve")
+		mustInit(t, db, alexUUID, "Alex")
+
+		base := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+		mustPunish(t, db, steve, WARN, "spam 1", base, 0)
+		mustPunish(t, db, alex, WARN, "spam 2", base.Add(time.Minute), 0)
+		// Same date: keyset falls back to id
+		mustPunish(t, db, steve, KICK, "fly 100%", base.Add(2*time.Minute), 0)
+		mustPunish(t, db, alex, KICK, "fly_hack", base.Add(2*time.Minute), 0)
+		mustPunish(t, db, steve, TEMPBAN, "grief", base.Add(3*time.Minute), time.Now().Add(time.Hour).UnixMilli())
+
+		reasons, page := searchAll(t, db, PunishmentFilter{}, 2)
+		want := []string{"grief", "fly_hack", "fly 100%", "spam 2", "spam 1"}
+		if len(reasons) != len(want) {
+			t.Fatalf("paged reasons: %q", reasons)
+		}
+		for i := range want {
+			if reasons[i] != want[i] {
+				t.Fatalf("paged reasons: %q, want %q", reasons, want)
+			}
+		}
+		if page.Totals[WARN] != 2 || page.Totals[KICK] != 2 || page.Totals[TEMPBAN] != 1 {
+			t.Fatalf("totals: %v", page.Totals)
+		}
+
+		// Totals ignore Level filter
+		reasons, page = searchAll(t, db, PunishmentFilter{Level: KICK}, 1)
+		if len(reasons) != 2 || page.Totals[WARN] != 2 {
+			t.Fatalf("level filter: %q %v", reasons, page.Totals)
+		}
+
+		// LIKE wildcards in keyword match literally
+		if reasons, _ := searchAll(t, db, PunishmentFilter{Keyword: "100%"}, 10); len(reasons) != 1 || reasons[0] != "fly 100%" {
+			t.Fatalf("keyword %%: %q", reasons)
+		}
+		if reasons, _ := searchAll(t, db, PunishmentFilter{Keyword: "FLY_"}, 10); len(reasons) != 1 || reasons[0] != "fly_hack" {
+			t.Fatalf("keyword _: %q", reasons)
+		}
+
+		filter := PunishmentFilter{From: base.Add(time.Minute), To: base.Add(3 * time.Minute), Punisher: "admin"}
+		if reasons, page := searchAll(t, db, filter, 10); len(reasons) != 3 || page.Totals[TEMPBAN] != 0 {
+			t.Fatalf("date range: %q %v", reasons, page.Totals)
+		}
+
+		if reasons, _ := searchAll(t, db, PunishmentFilter{ActiveOnly: true, Level: TEMPBAN}, 10); len(reasons) != 1 {
+			t.Fatalf("active only: %q", reasons)
+		}
+		if err := db.UnBan(steveUUID); err != nil {
+			t.Fatal(err)
+		}
+		if reasons, page := searchAll(t, db, PunishmentFilter{ActiveOnly: true, Level: TEMPBAN}, 10); len(reasons) != 0 || page.Totals[TEMPBAN] != 0 {
+			t.Fatalf("active only after UnBan: %q %v", reasons, page.Totals)
+		}
+
+		if reasons, _ := searchAll(t, db, PunishmentFilter{Punisher: "nobody"}, 10); len(reasons) != 0 {
+			t.Fatalf("unknown punisher: %q", reasons)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/systerapb"
 )
 
 // ReportData - Report Data on Database
@@ -16,6 +17,26 @@ type Report struct {
 	ReporterPlayerName string
 	TargetPlayerUUID   string `gorm:"index;"`
 	TargetPlayerName   string
+	Evidence           []Evidences `gorm:"foreignKey:ReportID;"`
+}
+
+// ToProtobuf - Convert to Protobuf
+func (r *Report) ToProtobuf() *systerapb.ReportEntry {
+	return &systerapb.ReportEntry{
+		Id: uint32(r.ID),
+		From: &systerapb.PlayerIdentity{
+			Uuid: r.ReporterPlayerUUID,
+			Name: r.ReporterPlayerName,
+		},
+		To: &systerapb.PlayerIdentity{
+			Uuid: r.TargetPlayerUUID,
+			Name: r.TargetPlayerName,
+		},
+		Message:  r.Message,
+		Date:     r.Date.UnixMilli(),
+		Server:   r.Server,
+		Evidence: evidenceToProtobuf(r.Evidence),
+	}
 }
 
 // SetReport - Set Report Data
@@ -44,3 +65,20 @@ func (s *Mysql) SetReport(from, to PlayerIdentity, server, message string) (Repo
 
 	return *report, nil
 }
+
+// GetReports - Get Reports (newest first / all players if targetUUID is empty)
+func (s *Mysql) GetReports(targetUUID string, limit int) ([]Report, error) {
+	var reports []Report
+
+	q := s.client.Model(&Report{}).Preload("Evidence", preloadEvidence).Order("date DESC").Limit(limit)
+	if targetUUID != "" {
+		q = q.Where("target_player_uuid = ?", targetUUID)
+	}
+
+	if r := q.Find(&reports); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Report] Error @ GetReports")
+		return nil, r.Error
+	}
+
+	return reports, nil
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"crypto/md5"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minotar/minecraft"
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/status"
+)
+
+// UUIDResolver - Resolve player name not known locally to UUID
+// Returns status.ErrPlayerNotFound.Error when the name does not exist.
+type UUIDResolver interface {
+	ResolveUUID(name string) (string, error)
+}
+
+// MojangResolver - Resolve by Mojang API
+type MojangResolver struct {
+	mc *minecraft.Minecraft
+}
+
+// NewMojangResolver - Create MojangResolver (timeout per request)
+func NewMojangResolver(timeout time.Duration) *MojangResolver {
+	mc := minecraft.NewMinecraft()
+	mc.Client = &http.Client{Timeout: timeout}
+	return &MojangResolver{mc: mc}
+}
+
+// ResolveUUID - Resolve name (dashed UUID)
+func (r *MojangResolver) ResolveUUID(name string) (string, error) {
+	id, err := r.mc.GetUUID(name)
+	if err != nil {
+		if strings.Contains(err.Error(), "user not found") {
+			return "", status.ErrPlayerNotFound.Error
+		}
+		return "", err
+	}
+
+	// Mojang API returns UUID without dashes
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// OfflineResolver - Resolve to offline-mode UUID (same as UUID.nameUUIDFromBytes("OfflinePlayer:<name>"))
+type OfflineResolver struct{}
+
+// ResolveUUID - Resolve name (never fails)
+func (OfflineResolver) ResolveUUID(name string) (string, error) {
+	return OfflineUUID(name), nil
+}
+
+// OfflineUUID - Offline-mode UUID of player name (MD5, version 3)
+func OfflineUUID(name string) string {
+	h := md5.Sum([]byte("OfflinePlayer:" + name))
+	h[6] = (h[6] & 0x0f) | 0x30
+	h[8] = (h[8] & 0x3f) | 0x80
+	return uuid.UUID(h).String()
+}
+
+// StubResolver - Resolve from fixed map (lower case name -> UUID)
+type StubResolver map[string]string
+
+// ResolveUUID - Resolve name
+func (r StubResolver) ResolveUUID(name string) (string, error) {
+	if id, ok := r[strings.ToLower(name)]; ok {
+		return id, nil
+	}
+	return "", status.ErrPlayerNotFound.Error
+}
+
+// cacheMaxEntries - Expired entries are pruned when cache grows beyond this
+const cacheMaxEntries = 10000
+
+type cachedUUID struct {
+	uuid    string
+	expires time.Time
+}
+
+// CachingResolver - Cache results of resolver (not found results for negativeTTL)
+// Other errors (ex. timeout, rate limit) are not cached.
+type CachingResolver struct {
+	resolver    UUIDResolver
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cachedUUID
+}
+
+// NewCachingResolver - Create CachingResolver
+func NewCachingResolver(resolver UUIDResolver, ttl, negativeTTL time.Duration) *CachingResolver {
+	return &CachingResolver{
+		resolver:    resolver,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		entries:     make(map[string]cachedUUID),
+	}
+}
+
+// ResolveUUID - Resolve name (cached)
+func (r *CachingResolver) ResolveUUID(name string) (string, error) {
+	key := strings.ToLower(name)
+	now := time.Now()
+
+	r.mu.Lock()
+	e, ok := r.entries[key]
+	r.mu.Unlock()
+	if ok && now.Before(e.expires) {
+		if e.uuid == "" {
+			return "", status.ErrPlayerNotFound.Error
+		}
+		return e.uuid, nil
+	}
+
+	id, err := r.resolver.ResolveUUID(name)
+	switch {
+	case err == nil:
+		r.store(key, cachedUUID{uuid: id, expires: now.Add(r.ttl)})
+	case err == status.ErrPlayerNotFound.Error:
+		r.store(key, cachedUUID{expires: now.Add(r.negativeTTL)})
+	default:
+		logrus.WithError(err).Warnf("[Resolver] Failed to resolve UUID: %s", name)
+	}
+	return id, err
+}
+
+func (r *CachingResolver) store(key string, e cachedUUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.entries) >= cacheMaxEntries {
+		now := time.Now()
+		for k, v := range r.entries {
+			if now.After(v.expires) {
+				delete(r.entries, k)
+			}
+		}
+		if len(r.entries) >= cacheMaxEntries {
+			r.entries = make(map[string]cachedUUID)
+		}
+	}
+	r.entries[key] = e
+}
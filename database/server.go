@@ -0,0 +1,139 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/systerapb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Servers - Registered server (renewed by heartbeat)
+type Servers struct {
+	ID            uint   `gorm:"primary_key;AutoIncrement;"`
+	Name          string `gorm:"index;unique;not null;"`
+	Address       string
+	Players       int32
+	MaxPlayers    int32
+	MOTD          string `gorm:"column:motd;type:text"`
+	State         string
+	Online        bool      `gorm:"index;"`
+	LastHeartbeat time.Time `gorm:"type:datetime"`
+	ExpiresAt     time.Time `gorm:"type:datetime;index;"`
+}
+
+// ToProtobuf - Convert to Protobuf
+func (s *Servers) ToProtobuf() *systerapb.ServerEntry {
+	return &systerapb.ServerEntry{
+		Name:          s.Name,
+		Address:       s.Address,
+		Players:       s.Players,
+		MaxPlayers:    s.MaxPlayers,
+		Motd:          s.MOTD,
+		State:         s.State,
+		Online:        s.Online,
+		LastHeartbeat: s.LastHeartbeat.UnixMilli(),
+	}
+}
+
+// ServerHeartbeat - Register or renew server (returns true if server came up)
+func (s *Mysql) ServerHeartbeat(server *Servers) (bool, error) {
+	up := false
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		var current Servers
+		r := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("name = ?", server.Name).Limit(1).Find(&current)
+		if r.Error != nil {
+			return r.Error
+		}
+		up = r.RowsAffected == 0 || !current.Online
+
+		// Upsert: first heartbeats of same server may race
+		server.Online = true
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"address", "players", "max_players", "motd", "state", "online", "last_heartbeat", "expires_at",
+			}),
+		}).Create(server).Error
+		if err != nil {
+			return err
+		}
+		if r.RowsAffected != 0 {
+			server.ID = current.ID
+		}
+		return nil
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[Server] Failed ServerHeartbeat (%s)", server.Name)
+		return false, err
+	}
+	return up, nil
+}
+
+// UnregisterServer - Mark server down (returns false if already down)
+func (s *Mysql) UnregisterServer(name string) (Servers, bool, error) {
+	r := s.client.Model(&Servers{}).Where("name = ? AND online = ?", name, true).Update("online", false)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Server] Failed UnregisterServer (%s)", name)
+		return Servers{}, false, r.Error
+	}
+	if r.RowsAffected == 0 {
+		return Servers{}, false, nil
+	}
+
+	server, err := s.GetServer(name)
+	return server, err == nil, err
+}
+
+// ExpireServers - Mark servers without heartbeat until now as down (returns expired servers)
+// Each server is expired by only one replica.
+func (s *Mysql) ExpireServers(now time.Time) ([]Servers, error) {
+	var candidates []Servers
+	r := s.client.Where("online = ? AND expires_at < ?", true, now).Find(&candidates)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Server] Failed get expired servers")
+		return nil, r.Error
+	}
+
+	var expired []Servers
+	for _, server := range candidates {
+		r := s.client.Model(&Servers{}).
+			Where("id = ? AND online = ? AND expires_at < ?", server.ID, true, now).
+			Update("online", false)
+		if r.Error != nil {
+			logrus.WithError(r.Error).Errorf("[Server] Failed expire server (%s)", server.Name)
+			return expired, r.Error
+		}
+		if r.RowsAffected != 0 {
+			server.Online = false
+			expired = append(expired, server)
+		}
+	}
+	return expired, nil
+}
+
+// GetServer - Get registered server
+func (s *Mysql) GetServer(name string) (Servers, error) {
+	var server Servers
+	if r := s.client.First(&server, "name = ?", name); r.Error != nil {
+		return Servers{}, r.Error
+	}
+	return server, nil
+}
+
+// GetServers - Get registered servers
+func (s *Mysql) GetServers(includeOffline bool) ([]Servers, error) {
+	var servers []Servers
+
+	q := s.client.Model(&Servers{}).Order("name")
+	if !includeOffline {
+		q = q.Where("online = ?", true)
+	}
+
+	if r := q.Find(&servers); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Server] Failed GetServers")
+		return nil, r.Error
+	}
+	return servers, nil
+}
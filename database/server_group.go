@@ -0,0 +1,104 @@
+package database
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/systerapb"
+	"gorm.io/gorm"
+)
+
+// ServerGroups - Named group of servers (Announce / Dispatch target)
+type ServerGroups struct {
+	ID      uint                 `gorm:"primary_key;AutoIncrement;"`
+	Name    string               `gorm:"index;unique;not null;"`
+	Members []ServerGroupMembers `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// ServerGroupMembers - Server name or glob pattern in group
+type ServerGroupMembers struct {
+	ID             uint   `gorm:"primary_key;AutoIncrement;"`
+	ServerGroupsID uint   `gorm:"foreign_key;index:idx_server_group_member,unique;"` // foreignKey
+	ServerName     string `gorm:"index:idx_server_group_member,unique;"`
+}
+
+// ToProtobuf - Convert to Protobuf
+func (g *ServerGroups) ToProtobuf() *systerapb.ServerGroupEntry {
+	var servers []string
+	for _, m := range g.Members {
+		servers = append(servers, m.ServerName)
+	}
+
+	return &systerapb.ServerGroupEntry{
+		Name:    g.Name,
+		Servers: servers,
+	}
+}
+
+// GetServerGroup - Get server group (gorm.ErrRecordNotFound if not exists)
+func (s *Mysql) GetServerGroup(name string) (ServerGroups, error) {
+	var group ServerGroups
+	r := s.client.Preload("Members").First(&group, "name = ?", name)
+	if r.Error != nil {
+		return ServerGroups{}, r.Error
+	}
+	return group, nil
+}
+
+// GetAllServerGroups - Get all server groups
+func (s *Mysql) GetAllServerGroups() ([]ServerGroups, error) {
+	var groups []ServerGroups
+	r := s.client.Model(&ServerGroups{}).Preload("Members").Order("name").Find(&groups)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[ServerGroup] Failed GetAllServerGroups")
+		return nil, r.Error
+	}
+	return groups, nil
+}
+
+// SetServerGroup - Create or replace server group
+func (s *Mysql) SetServerGroup(name string, servers []string) error {
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		group := ServerGroups{}
+		if r := tx.FirstOrCreate(&group, ServerGroups{Name: name}); r.Error != nil {
+			return r.Error
+		}
+
+		if r := tx.Where("server_groups_id = ?", group.ID).Delete(&ServerGroupMembers{}); r.Error != nil {
+			return r.Error
+		}
+
+		var members []ServerGroupMembers
+		seen := make(map[string]bool)
+		for _, server := range servers {
+			if seen[server] {
+				continue
+			}
+			seen[server] = true
+			members = append(members, ServerGroupMembers{
+				ServerGroupsID: group.ID,
+				ServerName:     server,
+			})
+		}
+		if len(members) == 0 {
+			return nil
+		}
+		return tx.Create(&members).Error
+	})
+	if err != nil {
+		logrus.WithError(err).Errorf("[ServerGroup] Failed SetServerGroup (%s)", name)
+	}
+	return err
+}
+
+// RemoveServerGroup - Remove server group
+func (s *Mysql) RemoveServerGroup(name string) error {
+	group, err := s.GetServerGroup(name)
+	if err != nil {
+		return err
+	}
+
+	if r := s.client.Select("Members").Delete(&group); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[ServerGroup] Failed RemoveServerGroup (%s)", name)
+		return r.Error
+	}
+	return nil
+}
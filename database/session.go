@@ -0,0 +1,188 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/systerapb"
+	"gorm.io/gorm"
+)
+
+// PlayerSessions - Time spent on server (LeftAt is nil while playing)
+type PlayerSessions struct {
+	ID         uint       `gorm:"primary_key;AutoIncrement;"`
+	PlayerUUID string     `gorm:"index;"`
+	ServerName string     `gorm:"index;"`
+	JoinedAt   time.Time  `gorm:"type:datetime;index;"`
+	LeftAt     *time.Time `gorm:"type:datetime;index;"`
+	Duration   int64      // millis (set on leave, up to now / lease expiry while playing)
+}
+
+// PlaytimeEntry - Playtime (millis) of player
+type PlaytimeEntry struct {
+	PlayerUUID string
+	Name       string
+	Playtime   int64
+}
+
+// ToProtobuf - Convert to Protobuf
+func (p *PlayerSessions) ToProtobuf() *systerapb.SessionEntry {
+	e := &systerapb.SessionEntry{
+		ServerName: p.ServerName,
+		JoinedAt:   p.JoinedAt.UnixMilli(),
+		Duration:   p.Duration,
+	}
+	if p.LeftAt != nil {
+		e.LeftAt = p.LeftAt.UnixMilli()
+	}
+	return e
+}
+
+// sessionEnd - End of open session: now, or presence lease expiry if it already lapsed
+func sessionEnd(lease *time.Time, now time.Time) time.Time {
+	if lease != nil && lease.Before(now) {
+		return *lease
+	}
+	return now
+}
+
+// openDuration - Duration of open session until end (millis)
+func openDuration(joined, end time.Time) int64 {
+	if d := end.Sub(joined).Milliseconds(); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// presenceLease - Presence lease of player (nil: no lease)
+func presenceLease(db *gorm.DB, uuid string) (*time.Time, error) {
+	var player Players
+	r := db.Select("presence_expires_at").Where("uuid = ?", uuid).Limit(1).Find(&player)
+	return player.PresenceExpiresAt, r.Error
+}
+
+// openSession - Start session on server
+func openSession(tx *gorm.DB, uuid, server string, at time.Time) error {
+	return tx.Create(&PlayerSessions{
+		PlayerUUID: uuid,
+		ServerName: server,
+		JoinedAt:   at,
+	}).Error
+}
+
+// closeSessions - Finish open sessions of players
+func closeSessions(tx *gorm.DB, uuids []string, at time.Time) error {
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	var open []PlayerSessions
+	if r := tx.Where("player_uuid IN ? AND left_at IS NULL", uuids).Find(&open); r.Error != nil {
+		return r.Error
+	}
+
+	for _, session := range open {
+		r := tx.Model(&session).Updates(map[string]interface{}{
+			"left_at":  at,
+			"duration": openDuration(session.JoinedAt, at),
+		})
+		if r.Error != nil {
+			return r.Error
+		}
+	}
+	return nil
+}
+
+// GetPlaytime - Playtime per server (including current session)
+func (s *Mysql) GetPlaytime(uuid string) (map[string]int64, error) {
+	var rows []struct {
+		ServerName string
+		Playtime   int64
+	}
+	r := s.client.Model(&PlayerSessions{}).
+		Select("server_name, SUM(duration) as playtime").
+		Where("player_uuid = ? AND left_at IS NOT NULL", uuid).
+		Group("server_name").
+		Scan(&rows)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Session] Failed GetPlaytime (%s)", uuid)
+		return nil, r.Error
+	}
+
+	playtime := make(map[string]int64)
+	for _, row := range rows {
+		playtime[row.ServerName] = row.Playtime
+	}
+
+	var open []PlayerSessions
+	if r := s.client.Where("player_uuid = ? AND left_at IS NULL", uuid).Find(&open); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Session] Failed GetPlaytime (%s)", uuid)
+		return nil, r.Error
+	}
+	if len(open) != 0 {
+		lease, err := presenceLease(s.client, uuid)
+		if err != nil {
+			logrus.WithError(err).Errorf("[Session] Failed GetPlaytime (%s)", uuid)
+			return nil, err
+		}
+		end := sessionEnd(lease, time.Now())
+		for _, session := range open {
+			playtime[session.ServerName] += openDuration(session.JoinedAt, end)
+		}
+	}
+
+	return playtime, nil
+}
+
+// GetSessions - Get sessions of player (newest first / all servers if server is empty)
+func (s *Mysql) GetSessions(uuid, server string, limit int) ([]PlayerSessions, error) {
+	var sessions []PlayerSessions
+
+	q := s.client.Model(&PlayerSessions{}).Where("player_uuid = ?", uuid).Order("joined_at DESC").Limit(limit)
+	if server != "" {
+		q = q.Where("server_name = ?", server)
+	}
+
+	if r := q.Find(&sessions); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Session] Failed GetSessions (%s)", uuid)
+		return nil, r.Error
+	}
+
+	lease, err := presenceLease(s.client, uuid)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Session] Failed GetSessions (%s)", uuid)
+		return nil, err
+	}
+	end := sessionEnd(lease, time.Now())
+	for i := range sessions {
+		if sessions[i].LeftAt == nil {
+			sessions[i].Duration = openDuration(sessions[i].JoinedAt, end)
+		}
+	}
+	return sessions, nil
+}
+
+// GetPlaytimeLeaderboard - Players ordered by playtime of finished sessions
+func (s *Mysql) GetPlaytimeLeaderboard(server string, since time.Time, limit int) ([]PlaytimeEntry, error) {
+	var entries []PlaytimeEntry
+
+	q := s.client.Table("player_sessions").
+		Select("player_sessions.player_uuid, players.name, SUM(player_sessions.duration) as playtime").
+		Joins("LEFT JOIN players ON players.uuid = player_sessions.player_uuid").
+		Where("player_sessions.left_at IS NOT NULL").
+		Group("player_sessions.player_uuid, players.name").
+		Order("playtime DESC").
+		Limit(limit)
+	if server != "" {
+		q = q.Where("player_sessions.server_name = ?", server)
+	}
+	if !since.IsZero() {
+		q = q.Where("player_sessions.joined_at >= ?", since)
+	}
+
+	if r := q.Scan(&entries); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Session] Failed GetPlaytimeLeaderboard")
+		return nil, r.Error
+	}
+	return entries, nil
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSessionsEndAtLease(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		mustInit(t, db, steveUUID, "Steve")
+		if err := db.SetPlayerServer(false, steveUUID, "lobby"); err != nil {
+			t.Fatal(err)
+		}
+		lease := time.Now().Add(-time.Hour)
+		if _, err := db.RenewPresence("lobby", []string{steveUUID}, lease); err != nil {
+			t.Fatal(err)
+		}
+
+		// Open session stops counting once lease lapsed
+		playtime, err := db.GetPlaytime(steveUUID)
+		if err != nil || playtime["lobby"] != 0 {
+			t.Fatalf("GetPlaytime(lapsed lease): %v %v", playtime, err)
+		}
+		sessions, _ := db.GetSessions(steveUUID, "", 10)
+		if len(sessions) != 1 || sessions[0].LeftAt != nil || sessions[0].Duration != 0 {
+			t.Fatalf("GetSessions(lapsed lease): %+v", sessions)
+		}
+
+		// Swept late: session ends at lease, not at sweep
+		if _, err := db.ExpirePresence(time.Now()); err != nil {
+			t.Fatal(err)
+		}
+		sessions, _ = db.GetSessions(steveUUID, "", 10)
+		if len(sessions) != 1 || sessions[0].LeftAt == nil || sessions[0].LeftAt.Sub(lease).Abs() > time.Second {
+			t.Fatalf("session after sweep: %+v", sessions)
+		}
+		board, _ := db.GetPlaytimeLeaderboard("", time.Time{}, 10)
+		if len(board) != 1 || board[0].Playtime != 0 {
+			t.Fatalf("GetPlaytimeLeaderboard: %+v", board)
+		}
+	})
+}
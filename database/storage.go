@@ -0,0 +1,137 @@
+package database
+
+import (
+	"time"
+)
+
+// Storage - Persistence used by server
+// Implemented by Mysql (GORM: MySQL / SQLite) and Memory.
+// Lookups of missing rows return gorm.ErrRecordNotFound (or status errors) in every implementation.
+type Storage interface {
+	PlayerStore
+	AddressStore
+	GroupStore
+	PunishmentStore
+	AppealStore
+	ReportStore
+	EvidenceStore
+	NoteStore
+	DispatchStore
+	AnnouncementStore
+	ServerStore
+	SessionStore
+}
+
+// PlayerStore - Player profiles, names and presence
+type PlayerStore interface {
+	NameToUUID(name string) (string, error)
+	GetIdentityByName(username string) (*PlayerIdentity, error)
+	GetNameHistory(playerUUID string) ([]KnownUsernames, error)
+
+	FindPlayer(uuid string) (Players, error)
+	FindPlayerByName(name string) (Players, error)
+	InitPlayerProfile(uuid, name, ipAddress, hostname string) (*PlayerLogin, error)
+
+	SetPlayerGroups(uuid string, groups []string) error
+	SetPlayerServer(isQuit bool, uuid, server string) error
+	SetPlayerSettings(uuid string, settings *PlayerSettings) error
+	AddIgnore(uuid string, target *PlayerIdentity) error
+	RemoveIgnore(uuid string, target *PlayerIdentity) error
+
+	CountOnlinePlayers() (map[string]int64, error)
+	RenewPresence(server string, uuids []string, expires time.Time) (int64, error)
+	ExpirePresence(now time.Time) ([]string, error)
+	ClearServerPresence(server string, at time.Time) ([]string, error)
+	GetOnlinePlayers(server string) ([]OnlinePlayer, error)
+}
+
+// AddressStore - Known addresses
+type AddressStore interface {
+	UpdateKnownAddress(playerUUID, address, hostname string) error
+	AltLookup(playerUUID string) ([]AltLookupData, error)
+}
+
+// GroupStore - Permission groups
+type GroupStore interface {
+	GetGroupData(name string) (Groups, error)
+	GetAllGroup() ([]Groups, error)
+	CreateGroup(group Groups) error
+	RemoveGroup(groupName string) error
+	UpdateGroup(newGroup Groups) error
+	AddPermission(groupName, target string, permissions []string) error
+	RemovePermission(groupName, target string, permissions []string) error
+}
+
+// PunishmentStore - Punishments
+type PunishmentStore interface {
+	GetPlayerPunishment(playerUUID string, filterLevel PunishLevel, includeExpired bool) ([]Punishments, error)
+	SetPlayerPunishment(force bool, from, to PlayerIdentity, level PunishLevel, reason string, date, expire int64) (bool, PunishRule, error)
+	UnBan(targetUUID string) error
+	SearchPunishments(filter PunishmentFilter, after *PunishmentCursor, limit int) (PunishmentPage, error)
+}
+
+// AppealStore - Punishment appeals
+type AppealStore interface {
+	SubmitAppeal(punishmentID uint, from PlayerIdentity, message string, cooldown time.Duration) (*PunishmentAppeals, error)
+	GetAppeals(state AppealState, playerUUID string, limit int) ([]PunishmentAppeals, error)
+	ReviewAppeal(id uint, reviewer PlayerIdentity, accept bool, note string) (*PunishmentAppeals, error)
+}
+
+// ReportStore - Reports
+type ReportStore interface {
+	SetReport(from, to PlayerIdentity, server, message string) (Report, error)
+	GetReports(targetUUID string, limit int) ([]Report, error)
+}
+
+// EvidenceStore - Evidence attached to punishments / reports
+type EvidenceStore interface {
+	AddEvidence(e *Evidences, blob []byte) error
+	GetEvidenceBlob(id uint) (*Evidences, []byte, error)
+}
+
+// NoteStore - Staff notes on players
+type NoteStore interface {
+	AddPlayerNote(n *PlayerNotes) error
+	GetPlayerNotes(playerUUID string, includeExpired bool) ([]PlayerNotes, error)
+	CountPlayerNotes(playerUUID string) (int64, error)
+	DeletePlayerNote(id uint) error
+}
+
+// DispatchStore - Dispatched commands and results
+type DispatchStore interface {
+	CreateDispatch(d *Dispatches) error
+	AddDispatchResult(id, serverName string, success bool, output string) error
+	GetDispatch(id string) (Dispatches, error)
+	GetDispatchHistory(target string, limit int) ([]Dispatches, error)
+}
+
+// AnnouncementStore - Scheduled announcements
+type AnnouncementStore interface {
+	CreateScheduledAnnouncement(a *ScheduledAnnouncements) error
+	GetScheduledAnnouncements(includeFinished bool) ([]ScheduledAnnouncements, error)
+	DeleteScheduledAnnouncement(id uint) error
+	ClaimDueAnnouncements(now time.Time, limit int, next func(a *ScheduledAnnouncements) *time.Time) ([]ScheduledAnnouncements, error)
+}
+
+// ServerStore - Server registry and server groups
+type ServerStore interface {
+	ServerHeartbeat(server *Servers) (bool, error)
+	UnregisterServer(name string) (Servers, bool, error)
+	ExpireServers(now time.Time) ([]Servers, error)
+	GetServer(name string) (Servers, error)
+	GetServers(includeOffline bool) ([]Servers, error)
+
+	GetServerGroup(name string) (ServerGroups, error)
+	GetAllServerGroups() ([]ServerGroups, error)
+	SetServerGroup(name string, servers []string) error
+	RemoveServerGroup(name string) error
+}
+
+// SessionStore - Play sessions
+type SessionStore interface {
+	GetPlaytime(uuid string) (map[string]int64, error)
+	GetSessions(uuid, server string, limit int) ([]PlayerSessions, error)
+	GetPlaytimeLeaderboard(server string, since time.Time, limit int) ([]PlaytimeEntry, error)
+}
+
+var _ Storage = (*Mysql)(nil)
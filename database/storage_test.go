@@ -0,0 +1,492 @@
+package database
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/status"
+	"gorm.io/gorm"
+)
+
+func TestMain(m *testing.M) {
+	logrus.SetOutput(ioutil.Discard)
+	os.Exit(m.Run())
+}
+
+// backend - Storage implementation under test
+type backend struct {
+	name string
+	open func(t *testing.T) Storage
+}
+
+// backends - Storage implementations every suite runs against
+// MySQL / PostgreSQL run only when SYSTERA_TEST_MYSQL_URL / SYSTERA_TEST_POSTGRES_URL
+// point to a disposable database (every test drops and recreates the schema).
+func backends() []backend {
+	b := []backend{
+		{"memory", func(t *testing.T) Storage {
+			return NewMemory()
+		}},
+		{"sqlite", func(t *testing.T) Storage {
+			return openMigrated(t, openSQLite(t))
+		}},
+	}
+
+	for _, env := range []struct{ name, key string }{
+		{"mysql", "SYSTERA_TEST_MYSQL_URL"},
+		{"postgres", "SYSTERA_TEST_POSTGRES_URL"},
+	} {
+		dsn := os.Getenv(env.key)
+		if dsn == "" {
+			continue
+		}
+		b = append(b, backend{env.name, func(t *testing.T) Storage {
+			return openMigrated(t, openExternal(t, dsn))
+		}})
+	}
+	return b
+}
+
+// openExternal - Connect to test database and drop its schema
+func openExternal(t *testing.T, dsn string) *Mysql {
+	t.Helper()
+
+	db := NewClient(dsn)
+	sqlDB, err := db.client.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db.SetUUIDResolver(OfflineResolver{})
+	// Drop everything: migrations can't be reverted past irreversible ones
+	tables, err := db.client.Migrator().GetTables()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range tables {
+		if err := db.client.Migrator().DropTable(table); err != nil {
+			t.Fatalf("DropTable(%s): %s", table, err)
+		}
+	}
+	return db
+}
+
+// openSQLite - Empty SQLite database closed when test ends
+func openSQLite(t *testing.T) *Mysql {
+	t.Helper()
+
+	db := NewSQLiteClient(filepath.Join(t.TempDir(), "systera.db"))
+	sqlDB, err := db.client.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db.SetUUIDResolver(OfflineResolver{})
+	return db
+}
+
+// openMigrated - Migrate db to latest
+func openMigrated(t *testing.T, db *Mysql) *Mysql {
+	t.Helper()
+
+	if _, err := db.MigrateUp(0); err != nil {
+		t.Fatalf("MigrateUp: %s", err)
+	}
+	return db
+}
+
+// forEachBackend - Run fn against fresh storage of every backend
+func forEachBackend(t *testing.T, fn func(t *testing.T, db Storage)) {
+	for _, b := range backends() {
+		b := b
+		t.Run(b.name, func(t *testing.T) {
+			fn(t, b.open(t))
+		})
+	}
+}
+
+// mustInit - Create player profile
+func mustInit(t *testing.T, db Storage, uuid, name string) *PlayerLogin {
+	t.Helper()
+
+	login, err := db.InitPlayerProfile(uuid, name, "192.0.2.1", "example.net")
+	if err != nil {
+		t.Fatalf("InitPlayerProfile(%s): %s", name, err)
+	}
+	return login
+}
+
+// mustPunish - Punish player (forced)
+func mustPunish(t *testing.T, db Storage, to PlayerIdentity, level PunishLevel, reason string, date time.Time, expire int64) {
+	t.Helper()
+
+	from := PlayerIdentity{UUID: "00000000-0000-0000-0000-0000000000ff", Name: "Admin"}
+	ok, rule, err := db.SetPlayerPunishment(true, from, to, level, reason, date.UnixMilli(), expire)
+	if err != nil || !ok {
+		t.Fatalf("SetPlayerPunishment(%s, %s): ok=%v rule=%+v err=%v", to.Name, level, ok, rule, err)
+	}
+}
+
+const (
+	steveUUID = "00000000-0000-0000-0000-000000000001"
+	alexUUID  = "00000000-0000-0000-0000-000000000002"
+)
+
+var (
+	steve = PlayerIdentity{UUID: steveUUID, Name: "Steve"}
+	alex  = PlayerIdentity{UUID: alexUUID, Name: "Alex"}
+)
+
+func TestDriverOf(t *testing.T) {
+	for dsn, want := range map[string]string{
+		"postgres://systera@localhost/systera":       "postgres",
+		"postgresql://systera@localhost/systera":     "postgres",
+		"sqlite://systera.db":                        "sqlite",
+		"mysql://root@tcp(localhost:3306)/systera":   "mysql",
+		"root:docker@tcp(localhost:3306)/systera":    "mysql",
+		"host=localhost user=systera dbname=systera": "mysql",
+	} {
+		if got := DriverOf(dsn); got != want {
+			t.Errorf("DriverOf(%q) = %s, want %s", dsn, got, want)
+		}
+	}
+}
+
+func TestPlayerProfile(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		login := mustInit(t, db, steveUUID, "Steve")
+		if !login.FirstJoin || login.NameChanged() || login.Ban != nil {
+			t.Fatalf("first login: %+v", login)
+		}
+
+		login = mustInit(t, db, steveUUID, "Steve2")
+		if login.FirstJoin || !login.NameChanged() || login.PreviousName != "Steve" {
+			t.Fatalf("renamed login: first=%v previous=%q", login.FirstJoin, login.PreviousName)
+		}
+
+		p, err := db.FindPlayerByName("steve2")
+		if err != nil || p.UUID != steveUUID {
+			t.Fatalf("FindPlayerByName: %+v %v", p, err)
+		}
+		if _, err := db.FindPlayer(alexUUID); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Fatalf("FindPlayer(missing): %v", err)
+		}
+
+		history, err := db.GetNameHistory(steveUUID)
+		if err != nil || len(history) != 2 {
+			t.Fatalf("GetNameHistory: %+v %v", history, err)
+		}
+
+		if err := db.SetPlayerGroups(steveUUID, []string{"default", "admin"}); err != nil {
+			t.Fatal(err)
+		}
+		if p, _ := db.FindPlayer(steveUUID); p.Groups != "default,admin" {
+			t.Fatalf("groups: %q", p.Groups)
+		}
+	})
+}
+
+func TestIgnoreList(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		mustInit(t, db, steveUUID, "Steve")
+		mustInit(t, db, alexUUID, "Alex")
+
+		if err := db.AddIgnore(steveUUID, &alex); err != nil {
+			t.Fatal(err)
+		}
+		if p, _ := db.FindPlayer(steveUUID); len(p.IgnoreList) != 1 || p.IgnoreList[0].UUID != alexUUID {
+			t.Fatalf("ignore list: %+v", p.IgnoreList)
+		}
+
+		if err := db.RemoveIgnore(steveUUID, &alex); err != nil {
+			t.Fatal(err)
+		}
+		if p, _ := db.FindPlayer(steveUUID); len(p.IgnoreList) != 0 {
+			t.Fatalf("ignore list after remove: %+v", p.IgnoreList)
+		}
+	})
+}
+
+func TestGroups(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		if err := db.CreateGroup(Groups{Name: "admin", Prefix: "&c"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.CreateGroup(Groups{Name: "admin"}); err == nil {
+			t.Fatal("CreateGroup(duplicate): expected error")
+		}
+
+		if err := db.AddPermission("admin", "global", []string{"systera.punish", "systera.report"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.RemovePermission("admin", "global", []string{"systera.report"}); err != nil {
+			t.Fatal(err)
+		}
+
+		g, err := db.GetGroupData("admin")
+		if err != nil || len(g.Permissions) != 1 || g.Permissions[0].Permission != "systera.punish" {
+			t.Fatalf("GetGroupData: %+v %v", g, err)
+		}
+
+		if err := db.UpdateGroup(Groups{Name: "admin", Prefix: "&4"}); err != nil {
+			t.Fatal(err)
+		}
+		if g, _ := db.GetGroupData("admin"); g.Prefix != "&4" {
+			t.Fatalf("prefix: %q", g.Prefix)
+		}
+
+		if err := db.RemoveGroup("admin"); err != nil {
+			t.Fatal(err)
+		}
+		if groups, _ := db.GetAllGroup(); len(groups) != 0 {
+			t.Fatalf("groups after remove: %+v", groups)
+		}
+	})
+}
+
+func TestPunishment(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		mustInit(t, db, steveUUID, "Steve")
+
+		now := time.Now()
+		mustPunish(t, db, steve, WARN, "spam", now.Add(-time.Hour), 0)
+		mustPunish(t, db, steve, TEMPBAN, "grief", now, now.Add(time.Hour).UnixMilli())
+
+		// Second TEMPBAN is rejected while TEMPBAN is active
+		from := PlayerIdentity{UUID: "00000000-0000-0000-0000-0000000000ff", Name: "Admin"}
+		ok, rule, err := db.SetPlayerPunishment(true, from, steve, TEMPBAN, "again", now.UnixMilli(), now.Add(time.Hour).UnixMilli())
+		if err != nil || ok || !rule.Cooldown {
+			t.Fatalf("duplicate TEMPBAN: ok=%v rule=%+v err=%v", ok, rule, err)
+		}
+
+		all, err := db.GetPlayerPunishment(steveUUID, WARN, false)
+		if err != nil || len(all) != 2 || all[0].Level != WARN {
+			t.Fatalf("GetPlayerPunishment: %+v %v", all, err)
+		}
+
+		login := mustInit(t, db, steveUUID, "Steve")
+		if login.Ban == nil || login.Ban.Level != TEMPBAN {
+			t.Fatalf("active ban on login: %+v", login.Ban)
+		}
+
+		if err := db.UnBan(steveUUID); err != nil {
+			t.Fatal(err)
+		}
+		bans, _ := db.GetPlayerPunishment(steveUUID, TEMPBAN, false)
+		if len(bans) != 0 {
+			t.Fatalf("bans after UnBan: %+v", bans)
+		}
+		history, _ := db.GetPlayerPunishment(steveUUID, TEMPBAN, true)
+		if len(history) != 1 || history[0].Available {
+			t.Fatalf("history after UnBan: %+v", history)
+		}
+	})
+}
+
+func TestPunishmentNoProfile(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		from := PlayerIdentity{UUID: "00000000-0000-0000-0000-0000000000ff", Name: "Admin"}
+		ok, rule, err := db.SetPlayerPunishment(false, from, alex, PERMBAN, "cheat", time.Now().UnixMilli(), 0)
+		if err != nil || ok || !rule.NoProfile {
+			t.Fatalf("unforced punish without profile: ok=%v rule=%+v err=%v", ok, rule, err)
+		}
+	})
+}
+
+func TestReports(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		if _, err := db.SetReport(steve, alex, "lobby", "fly hack"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.SetReport(steve, PlayerIdentity{UUID: steveUUID, Name: "Steve"}, "lobby", "self"); err != nil {
+			t.Fatal(err)
+		}
+
+		reports, err := db.GetReports(alexUUID, 10)
+		if err != nil || len(reports) != 1 || reports[0].Message != "fly hack" || reports[0].ID == 0 {
+			t.Fatalf("GetReports: %+v %v", reports, err)
+		}
+	})
+}
+
+func TestPresence(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		mustInit(t, db, steveUUID, "Steve")
+		mustInit(t, db, alexUUID, "Alex")
+		if err := db.SetPlayerServer(false, steveUUID, "lobby"); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SetPlayerServer(false, alexUUID, "survival"); err != nil {
+			t.Fatal(err)
+		}
+
+		// Join starts lease, so presence expires even without Heartbeat
+		if expired, _ := db.ExpirePresence(time.Now()); len(expired) != 0 {
+			t.Fatalf("ExpirePresence(join lease): %v", expired)
+		}
+
+		// Heartbeat renews lease of steve only
+		if n, err := db.RenewPresence("lobby", []string{steveUUID}, time.Now().Add(time.Hour)); err != nil || n != 1 {
+			t.Fatalf("RenewPresence: %d %v", n, err)
+		}
+		expired, err := db.ExpirePresence(time.Now().Add(DefaultPresenceTTL + time.Second))
+		if err != nil || len(expired) != 1 || expired[0] != alexUUID {
+			t.Fatalf("ExpirePresence(join lease lapsed): %v %v", expired, err)
+		}
+		expired, err = db.ExpirePresence(time.Now().Add(2 * time.Hour))
+		if err != nil || len(expired) != 1 || expired[0] != steveUUID {
+			t.Fatalf("ExpirePresence(renewed lease lapsed): %v %v", expired, err)
+		}
+
+		if err := db.SetPlayerServer(false, alexUUID, "survival"); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SetPlayerServer(false, steveUUID, "lobby"); err != nil {
+			t.Fatal(err)
+		}
+		// Server down clears its players regardless of lease
+		cleared, err := db.ClearServerPresence("lobby", time.Now())
+		if err != nil || len(cleared) != 1 || cleared[0] != steveUUID {
+			t.Fatalf("ClearServerPresence: %v %v", cleared, err)
+		}
+
+		online, err := db.GetOnlinePlayers("")
+		if err != nil || len(online) != 1 || online[0].UUID != alexUUID {
+			t.Fatalf("GetOnlinePlayers: %+v %v", online, err)
+		}
+		counts, _ := db.CountOnlinePlayers()
+		if counts["survival"] != 1 || counts["lobby"] != 0 {
+			t.Fatalf("CountOnlinePlayers: %v", counts)
+		}
+
+		// Quit from other server is ignored
+		if err := db.SetPlayerServer(true, alexUUID, "lobby"); err != nil {
+			t.Fatal(err)
+		}
+		if p, _ := db.FindPlayer(alexUUID); p.CurrentServer != "survival" {
+			t.Fatalf("current server after foreign quit: %q", p.CurrentServer)
+		}
+
+		sessions, err := db.GetSessions(steveUUID, "", 10)
+		if err != nil || len(sessions) != 2 || sessions[0].LeftAt == nil {
+			t.Fatalf("GetSessions: %+v %v", sessions, err)
+		}
+	})
+}
+
+func TestServers(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		now := time.Now()
+		up, err := db.ServerHeartbeat(&Servers{Name: "lobby", LastHeartbeat: now, ExpiresAt: now.Add(time.Minute)})
+		if err != nil || !up {
+			t.Fatalf("ServerHeartbeat(first): %v %v", up, err)
+		}
+		if up, _ := db.ServerHeartbeat(&Servers{Name: "lobby", Players: 3, LastHeartbeat: now, ExpiresAt: now.Add(time.Minute)}); up {
+			t.Fatal("ServerHeartbeat(renew): server came up again")
+		}
+		if servers, _ := db.GetServers(true); len(servers) != 1 || servers[0].Players != 3 {
+			t.Fatalf("servers after renew: %+v", servers)
+		}
+
+		expired, err := db.ExpireServers(now.Add(2 * time.Minute))
+		if err != nil || len(expired) != 1 || expired[0].Name != "lobby" {
+			t.Fatalf("ExpireServers: %+v %v", expired, err)
+		}
+		if servers, _ := db.GetServers(false); len(servers) != 0 {
+			t.Fatalf("online servers: %+v", servers)
+		}
+		if up, err := db.ServerHeartbeat(&Servers{Name: "lobby", LastHeartbeat: now, ExpiresAt: now.Add(3 * time.Minute)}); err != nil || !up {
+			t.Fatalf("ServerHeartbeat(after expiry): %v %v", up, err)
+		}
+		if servers, _ := db.GetServers(true); len(servers) != 1 || !servers[0].Online {
+			t.Fatalf("servers after expiry: %+v", servers)
+		}
+
+		if err := db.SetServerGroup("games", []string{"skywars-*", "bedwars"}); err != nil {
+			t.Fatal(err)
+		}
+		g, err := db.GetServerGroup("games")
+		if err != nil || len(g.Members) != 2 {
+			t.Fatalf("GetServerGroup: %+v %v", g, err)
+		}
+		if err := db.RemoveServerGroup("games"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.GetServerGroup("games"); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Fatalf("GetServerGroup(removed): %v", err)
+		}
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		d := &Dispatches{UUID: "d-1", Target: "lobby", Command: "say hi", Date: time.Now()}
+		if err := db.CreateDispatch(d); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.AddDispatchResult("d-1", "lobby", true, "ok"); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := db.GetDispatch("d-1")
+		if err != nil || len(got.Results) != 1 || !got.Results[0].Success {
+			t.Fatalf("GetDispatch: %+v %v", got, err)
+		}
+		if _, err := db.GetDispatch("missing"); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Fatalf("GetDispatch(missing): %v", err)
+		}
+
+		history, err := db.GetDispatchHistory("lobby", 10)
+		if err != nil || len(history) != 1 {
+			t.Fatalf("GetDispatchHistory: %+v %v", history, err)
+		}
+	})
+}
+
+func TestScheduledAnnouncements(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, db Storage) {
+		now := time.Now()
+		past := now.Add(-time.Second)
+		a := &ScheduledAnnouncements{
+			Target:   "global",
+			NextRun:  &past,
+			Cron:     "@every 1m",
+			Messages: []ScheduledAnnouncementMessages{{Message: "first"}, {Message: "second"}},
+		}
+		if err := db.CreateScheduledAnnouncement(a); err != nil {
+			t.Fatal(err)
+		}
+
+		next := now.Add(time.Minute)
+		due, err := db.ClaimDueAnnouncements(now, 10, func(*ScheduledAnnouncements) *time.Time { return &next })
+		if err != nil || len(due) != 1 || due[0].Message() != "first" {
+			t.Fatalf("ClaimDueAnnouncements: %+v %v", due, err)
+		}
+
+		// Rescheduled before publishing: nothing due until next run
+		if due, _ := db.ClaimDueAnnouncements(now, 10, func(*ScheduledAnnouncements) *time.Time { return nil }); len(due) != 0 {
+			t.Fatalf("claimed twice: %+v", due)
+		}
+		due, _ = db.ClaimDueAnnouncements(next, 10, func(*ScheduledAnnouncements) *time.Time { return nil })
+		if len(due) != 1 || due[0].Message() != "second" {
+			t.Fatalf("second run: %+v", due)
+		}
+
+		if list, _ := db.GetScheduledAnnouncements(false); len(list) != 0 {
+			t.Fatalf("finished announcement listed: %+v", list)
+		}
+		if err := db.DeleteScheduledAnnouncement(a.ID); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.DeleteScheduledAnnouncement(a.ID); !errors.Is(err, status.ErrAnnouncementNotFound.Error) {
+			t.Fatalf("DeleteScheduledAnnouncement(missing): %v", err)
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var profileCacheDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "profile_cache", "requests_total"),
+	"Total number of player profile lookups, by cache result.",
+	[]string{"result"}, nil,
+)
+
+// CacheStatsFunc - Returns hit / miss count
+type CacheStatsFunc func() (hits, misses uint64)
+
+type profileCacheCollector struct {
+	stats CacheStatsFunc
+}
+
+// RegisterProfileCache - Register profile cache counters (evaluated on scrape)
+func RegisterProfileCache(stats CacheStatsFunc) {
+	prometheus.MustRegister(&profileCacheCollector{stats: stats})
+}
+
+func (c *profileCacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- profileCacheDesc
+}
+
+func (c *profileCacheCollector) Collect(ch chan<- prometheus.Metric) {
+	hits, misses := c.stats()
+	ch <- prometheus.MustNewConstMetric(profileCacheDesc, prometheus.CounterValue, float64(hits), "hit")
+	ch <- prometheus.MustNewConstMetric(profileCacheDesc, prometheus.CounterValue, float64(misses), "miss")
+}
@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+const namespace = "systera"
+
+var (
+	// GRPCRequests - Handled gRPC calls per method / status code
+	GRPCRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Total number of gRPC calls handled, by method and status code.",
+	}, []string{"method", "code"})
+
+	// GRPCDuration - Latency of handled gRPC calls per method
+	GRPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of gRPC calls, by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	// StreamPublish - Published stream messages per topic / result
+	StreamPublish = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "stream",
+		Name:      "publish_total",
+		Help:      "Total number of stream publishes, by topic and result.",
+	}, []string{"topic", "result"})
+
+	// DBQueryDuration - Latency of database queries per statement type
+	DBQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Latency of database queries, by statement type and result.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"statement", "result"})
+)
+
+func init() {
+	prometheus.MustRegister(GRPCRequests, GRPCDuration, StreamPublish, DBQueryDuration)
+}
+
+// ObservePublish - Record stream publish result
+// topic is the channel topic (ex. "player"), not the full per-target channel.
+func ObservePublish(topic string, err error) {
+	StreamPublish.WithLabelValues(topic, result(err)).Inc()
+}
+
+// ObserveQuery - Record database query duration
+func ObserveQuery(sql string, elapsed time.Duration, err error) {
+	DBQueryDuration.WithLabelValues(statement(sql), result(err)).Observe(elapsed.Seconds())
+}
+
+// Serve - Start metrics HTTP listener
+func Serve(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	logrus.WithField("listen", addr).Infof("[Metrics] Listening %s", addr)
+	return http.ListenAndServe(addr, mux)
+}
+
+func result(err error) string {
+	if err != nil {
+		return "failure"
+	}
+	return "success"
+}
+
+// statement - Reduce SQL to its leading keyword (keep label cardinality low)
+func statement(sql string) string {
+	sql = strings.TrimSpace(sql)
+	if i := strings.IndexAny(sql, " \t\n("); i > 0 {
+		sql = sql[:i]
+	}
+	switch s := strings.ToUpper(sql); s {
+	case "SELECT", "INSERT", "UPDATE", "DELETE":
+		return s
+	default:
+		return "OTHER"
+	}
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+var onlinePlayersDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "players", "online"),
+	"Number of players with a current server, by server.",
+	[]string{"server"}, nil,
+)
+
+// OnlinePlayersFunc - Returns online player count per server
+type OnlinePlayersFunc func() (map[string]int64, error)
+
+type onlinePlayersCollector struct {
+	fetch OnlinePlayersFunc
+}
+
+// RegisterOnlinePlayers - Register online players gauge (evaluated on scrape)
+func RegisterOnlinePlayers(fetch OnlinePlayersFunc) {
+	prometheus.MustRegister(&onlinePlayersCollector{fetch: fetch})
+}
+
+func (c *onlinePlayersCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- onlinePlayersDesc
+}
+
+func (c *onlinePlayersCollector) Collect(ch chan<- prometheus.Metric) {
+	counts, err := c.fetch()
+	if err != nil {
+		logrus.WithError(err).Errorf("[Metrics] Failed to collect online players")
+		return
+	}
+
+	for server, count := range counts {
+		ch <- prometheus.MustNewConstMetric(onlinePlayersDesc, prometheus.GaugeValue, float64(count), server)
+	}
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"time"
+
+	"github.com/synchthia/systera-api/database"
+	sts "github.com/synchthia/systera-api/status"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *grpcServer) CreateScheduledAnnouncement(ctx context.Context, e *pb.CreateScheduledAnnouncementRequest) (*pb.CreateScheduledAnnouncementResponse, error) {
+	entry := e.GetEntry()
+	if entry.GetTarget() == "" || len(entry.GetMessages()) == 0 {
+		return &pb.CreateScheduledAnnouncementResponse{}, status.Error(codes.InvalidArgument, "target and messages are required")
+	}
+	if err := validateTarget(entry.Target); err != nil {
+		return &pb.CreateScheduledAnnouncementResponse{}, status.Errorf(codes.InvalidArgument, "invalid target: %s", err)
+	}
+
+	now := time.Now()
+	next := now
+	if entry.At > 0 {
+		next = time.UnixMilli(entry.At)
+	}
+	if entry.Cron != "" {
+		schedule, err := parseCron(entry.Cron)
+		if err != nil {
+			return &pb.CreateScheduledAnnouncementResponse{}, status.Errorf(codes.InvalidArgument, "invalid cron: %s", err)
+		}
+		if entry.At == 0 {
+			next = schedule.Next(now)
+		}
+	}
+
+	a := &database.ScheduledAnnouncements{
+		Target:     entry.Target,
+		Cron:       entry.Cron,
+		NextRun:    &next,
+		AuthorUUID: entry.Author.GetUuid(),
+		AuthorName: entry.Author.GetName(),
+		CreatedAt:  now,
+	}
+	for _, m := range entry.Messages {
+		a.Messages = append(a.Messages, database.ScheduledAnnouncementMessages{Message: m})
+	}
+
+	if err := s.db.CreateScheduledAnnouncement(a); err != nil {
+		return &pb.CreateScheduledAnnouncementResponse{}, err
+	}
+	return &pb.CreateScheduledAnnouncementResponse{Entry: a.ToProtobuf()}, nil
+}
+
+func (s *grpcServer) ListScheduledAnnouncements(ctx context.Context, e *pb.ListScheduledAnnouncementsRequest) (*pb.ListScheduledAnnouncementsResponse, error) {
+	list, err := s.db.GetScheduledAnnouncements(e.IncludeFinished)
+
+	var entries []*pb.ScheduledAnnouncement
+	for _, a := range list {
+		entries = append(entries, a.ToProtobuf())
+	}
+	return &pb.ListScheduledAnnouncementsResponse{Entries: entries}, err
+}
+
+func (s *grpcServer) DeleteScheduledAnnouncement(ctx context.Context, e *pb.DeleteScheduledAnnouncementRequest) (*pb.Empty, error) {
+	if err := s.db.DeleteScheduledAnnouncement(uint(e.Id)); err != nil {
+		return &pb.Empty{}, sts.Convert(err).Err()
+	}
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"time"
+
+	"github.com/synchthia/systera-api/database"
+	sts "github.com/synchthia/systera-api/status"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// appealCooldown - Wait after review until punishment can be appealed again
+var appealCooldown = 24 * time.Hour
+
+// SetAppealCooldown - Set wait after review until punishment can be appealed again
+func SetAppealCooldown(d time.Duration) {
+	appealCooldown = d
+}
+
+func (s *grpcServer) SubmitAppeal(ctx context.Context, e *pb.SubmitAppealRequest) (*pb.SubmitAppealResponse, error) {
+	if e.PunishmentId == 0 || e.From.GetUuid() == "" {
+		return &pb.SubmitAppealResponse{}, status.Error(codes.InvalidArgument, "punishment_id and from are required")
+	}
+
+	from := database.PlayerIdentity{
+		UUID: e.From.Uuid,
+		Name: e.From.Name,
+	}
+	appeal, err := s.db.SubmitAppeal(uint(e.PunishmentId), from, e.Message, appealCooldown)
+	if err != nil {
+		return &pb.SubmitAppealResponse{}, sts.Convert(err).Err()
+	}
+
+	entry := appeal.ToProtobuf()
+	s.stream.PublishAppeal(entry)
+	return &pb.SubmitAppealResponse{Entry: entry}, nil
+}
+
+func (s *grpcServer) ListAppeals(ctx context.Context, e *pb.ListAppealsRequest) (*pb.ListAppealsResponse, error) {
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	appeals, err := s.db.GetAppeals(database.AppealState(e.State), e.Uuid, limit)
+
+	var entries []*pb.AppealEntry
+	for _, a := range appeals {
+		entries = append(entries, a.ToProtobuf())
+	}
+	return &pb.ListAppealsResponse{Entries: entries}, err
+}
+
+func (s *grpcServer) ReviewAppeal(ctx context.Context, e *pb.ReviewAppealRequest) (*pb.ReviewAppealResponse, error) {
+	if e.Id == 0 {
+		return &pb.ReviewAppealResponse{}, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	reviewer := database.PlayerIdentity{
+		UUID: e.Reviewer.GetUuid(),
+		Name: e.Reviewer.GetName(),
+	}
+	appeal, err := s.db.ReviewAppeal(uint(e.Id), reviewer, e.Accept, e.Note)
+	if err != nil {
+		return &pb.ReviewAppealResponse{}, sts.Convert(err).Err()
+	}
+
+	entry := appeal.ToProtobuf()
+	s.stream.PublishAppeal(entry)
+	return &pb.ReviewAppealResponse{Entry: entry}, nil
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/synchthia/systera-api/database"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// appealLimitStorage - Records limit passed to GetAppeals
+type appealLimitStorage struct {
+	database.Storage
+	limit int
+}
+
+func (s *appealLimitStorage) GetAppeals(state database.AppealState, playerUUID string, limit int) ([]database.PunishmentAppeals, error) {
+	s.limit = limit
+	return s.Storage.GetAppeals(state, playerUUID, limit)
+}
+
+func TestListAppealsLimit(t *testing.T) {
+	db := &appealLimitStorage{Storage: database.NewMemory()}
+	s := newTestServer(db)
+
+	for limit, want := range map[int32]int{0: 50, -1: 50, 20: 20, 500: 500, 100000: 500} {
+		if _, err := s.ListAppeals(context.Background(), &pb.ListAppealsRequest{Limit: limit}); err != nil {
+			t.Fatal(err)
+		}
+		if db.limit != want {
+			t.Errorf("ListAppeals(limit=%d) queried %d, want %d", limit, db.limit, want)
+		}
+	}
+}
+
+func TestAppealFlow(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		ctx := context.Background()
+		login(t, s, steve)
+		punish(t, s, steve, pb.PunishLevel_TEMPBAN, "grief", time.Now().Add(time.Hour))
+
+		bans, _ := s.GetPlayerPunish(ctx, &pb.GetPlayerPunishRequest{Uuid: steve.Uuid, FilterLevel: pb.PunishLevel_TEMPBAN})
+		id := bans.Entry[0].Id
+
+		_, err := s.SubmitAppeal(ctx, &pb.SubmitAppealRequest{PunishmentId: id})
+		wantCode(t, err, codes.InvalidArgument)
+		_, err = s.SubmitAppeal(ctx, &pb.SubmitAppealRequest{PunishmentId: id, From: alex, Message: "not me"})
+		wantCode(t, err, codes.FailedPrecondition)
+
+		res, err := s.SubmitAppeal(ctx, &pb.SubmitAppealRequest{PunishmentId: id, From: steve, Message: "sorry"})
+		if err != nil || res.Entry.State != pb.AppealState_APPEAL_OPEN {
+			t.Fatalf("SubmitAppeal: %+v %v", res, err)
+		}
+		_, err = s.SubmitAppeal(ctx, &pb.SubmitAppealRequest{PunishmentId: id, From: steve, Message: "sorry"})
+		wantCode(t, err, codes.AlreadyExists)
+
+		review, err := s.ReviewAppeal(ctx, &pb.ReviewAppealRequest{Id: res.Entry.Id, Reviewer: admin, Accept: true})
+		if err != nil || review.Entry.State != pb.AppealState_APPEAL_ACCEPTED {
+			t.Fatalf("ReviewAppeal: %+v %v", review, err)
+		}
+		_, err = s.ReviewAppeal(ctx, &pb.ReviewAppealRequest{Id: res.Entry.Id, Reviewer: admin})
+		wantCode(t, err, codes.FailedPrecondition)
+
+		if again := login(t, s, steve); again.ActiveBan != nil {
+			t.Fatalf("ban active after accepted appeal: %+v", again.ActiveBan)
+		}
+	})
+}
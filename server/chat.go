@@ -5,17 +5,16 @@ import (
 
 	"github.com/synchthia/systera-api/database"
 	"github.com/synchthia/systera-api/status"
-	"github.com/synchthia/systera-api/stream"
 	"github.com/synchthia/systera-api/systerapb"
 )
 
 func (s *grpcServer) Chat(ctx context.Context, e *systerapb.ChatRequest) (*systerapb.Empty, error) {
-	return &systerapb.Empty{}, stream.PublishChat(e.GetEntry())
+	return &systerapb.Empty{}, s.stream.PublishChat(e.GetEntry())
 }
 
 func (s *grpcServer) AddChatIgnore(ctx context.Context, e *systerapb.AddChatIgnoreRequest) (*systerapb.ChatIgnoreResponse, error) {
 	if e.Target.Uuid == "" {
-		if res, err := s.mysql.GetIdentityByName(e.Target.Name); err != nil {
+		if res, err := s.db.GetIdentityByName(e.Target.Name); err != nil {
 			if err == status.ErrPlayerNotFound.Error {
 				return &systerapb.ChatIgnoreResponse{Result: systerapb.CallResult_NOT_FOUND}, nil
 			} else {
@@ -26,7 +25,7 @@ func (s *grpcServer) AddChatIgnore(ctx context.Context, e *systerapb.AddChatIgno
 		}
 	}
 
-	err := s.mysql.AddIgnore(e.Uuid, &database.PlayerIdentity{UUID: e.Target.Uuid, Name: e.Target.Name})
+	err := s.db.AddIgnore(e.Uuid, &database.PlayerIdentity{UUID: e.Target.Uuid, Name: e.Target.Name})
 	if err == status.ErrPlayerAlreadyExists.Error {
 		return &systerapb.ChatIgnoreResponse{
 			Result: systerapb.CallResult_DUPLICATED,
@@ -41,7 +40,7 @@ func (s *grpcServer) AddChatIgnore(ctx context.Context, e *systerapb.AddChatIgno
 
 func (s *grpcServer) RemoveChatIgnore(ctx context.Context, e *systerapb.RemoveChatIgnoreRequest) (*systerapb.ChatIgnoreResponse, error) {
 	if e.Target.Uuid == "" {
-		if res, err := s.mysql.GetIdentityByName(e.Target.Name); err != nil {
+		if res, err := s.db.GetIdentityByName(e.Target.Name); err != nil {
 			if err == status.ErrPlayerNotFound.Error {
 				return &systerapb.ChatIgnoreResponse{Result: systerapb.CallResult_NOT_FOUND}, nil
 			} else {
@@ -52,7 +51,7 @@ func (s *grpcServer) RemoveChatIgnore(ctx context.Context, e *systerapb.RemoveCh
 		}
 	}
 
-	err := s.mysql.RemoveIgnore(e.Uuid, &database.PlayerIdentity{UUID: e.Target.Uuid, Name: e.Target.Name})
+	err := s.db.RemoveIgnore(e.Uuid, &database.PlayerIdentity{UUID: e.Target.Uuid, Name: e.Target.Name})
 	return &systerapb.ChatIgnoreResponse{
 		Result:   systerapb.CallResult_SUCCESS,
 		Identity: e.Target,
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/synchthia/systera-api/database"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dispatchPollInterval - Interval to check reported results while waiting
+const dispatchPollInterval = 200 * time.Millisecond
+
+// maxDispatchWait - Upper bound of DispatchRequest.wait_timeout
+const maxDispatchWait = 30 * time.Second
+
+func (s *grpcServer) Dispatch(ctx context.Context, e *pb.DispatchRequest) (*pb.DispatchResponse, error) {
+	if e.WaitTimeout < 0 || e.WaitTimeout > maxDispatchWait.Milliseconds() {
+		return &pb.DispatchResponse{}, status.Errorf(codes.InvalidArgument, "wait_timeout must be between 0 and %d", maxDispatchWait.Milliseconds())
+	}
+
+	targets, err := s.targets.resolve(e.Target)
+	if err != nil {
+		return &pb.DispatchResponse{}, err
+	}
+
+	d := &database.Dispatches{
+		UUID:       uuid.NewString(),
+		Target:     e.Target,
+		Command:    e.Cmd,
+		IssuerUUID: e.From.GetUuid(),
+		IssuerName: e.From.GetName(),
+		Date:       time.Now(),
+	}
+	if err := s.db.CreateDispatch(d); err != nil {
+		return &pb.DispatchResponse{}, err
+	}
+
+	s.mu.Lock()
+	for _, target := range targets {
+		if err = s.stream.PublishCommand(target, e.Cmd, d.UUID); err != nil {
+			break
+		}
+	}
+	s.mu.Unlock()
+	if err != nil || e.WaitTimeout <= 0 {
+		return &pb.DispatchResponse{Id: d.UUID, Targets: targets}, err
+	}
+
+	results, err := s.waitDispatchResults(ctx, d.UUID, targets, time.Duration(e.WaitTimeout)*time.Millisecond)
+	return &pb.DispatchResponse{Id: d.UUID, Results: results, Targets: targets}, err
+}
+
+// waitDispatchResults - Wait until expected servers reported (or timeout)
+// Results are read from database, so reports received by other replicas are included.
+func (s *grpcServer) waitDispatchResults(ctx context.Context, id string, targets []string, timeout time.Duration) ([]*pb.DispatchResult, error) {
+	expected := make(map[string]bool)
+	for _, target := range targets {
+		if target != "global" {
+			expected[target] = true
+			continue
+		}
+
+		// Servers with online players
+		counts, err := s.db.CountOnlinePlayers()
+		if err != nil {
+			return nil, err
+		}
+		for server := range counts {
+			expected[server] = true
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(dispatchPollInterval)
+	defer ticker.Stop()
+
+	var results []*pb.DispatchResult
+	for {
+		d, err := s.db.GetDispatch(id)
+		if err != nil {
+			return nil, err
+		}
+
+		results = nil
+		reported := 0
+		for _, r := range d.Results {
+			results = append(results, r.ToProtobuf())
+			if expected[r.ServerName] {
+				reported++
+			}
+		}
+		if len(expected) != 0 && reported == len(expected) {
+			return results, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			// Timeout is not an error: return results reported so far
+			return results, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *grpcServer) ReportDispatchResult(ctx context.Context, e *pb.ReportDispatchResultRequest) (*pb.Empty, error) {
+	err := s.db.AddDispatchResult(e.Id, e.ServerName, e.Success, e.Output)
+	return &pb.Empty{}, err
+}
+
+func (s *grpcServer) GetDispatchHistory(ctx context.Context, e *pb.GetDispatchHistoryRequest) (*pb.GetDispatchHistoryResponse, error) {
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	history, err := s.db.GetDispatchHistory(e.Target, limit)
+
+	var entries []*pb.DispatchEntry
+	for _, d := range history {
+		entries = append(entries, d.ToProtobuf())
+	}
+	return &pb.GetDispatchHistoryResponse{Entries: entries}, err
+}
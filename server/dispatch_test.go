@@ -0,0 +1,34 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/synchthia/systera-api/database"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+)
+
+// dispatchLimitStorage - Records limit passed to GetDispatchHistory
+type dispatchLimitStorage struct {
+	database.Storage
+	limit int
+}
+
+func (s *dispatchLimitStorage) GetDispatchHistory(target string, limit int) ([]database.Dispatches, error) {
+	s.limit = limit
+	return s.Storage.GetDispatchHistory(target, limit)
+}
+
+func TestGetDispatchHistoryLimit(t *testing.T) {
+	db := &dispatchLimitStorage{Storage: database.NewMemory()}
+	s := newTestServer(db)
+
+	for limit, want := range map[int32]int{0: 50, -1: 50, 20: 20, 500: 500, 100000: 500} {
+		if _, err := s.GetDispatchHistory(context.Background(), &pb.GetDispatchHistoryRequest{Limit: limit}); err != nil {
+			t.Fatal(err)
+		}
+		if db.limit != want {
+			t.Errorf("GetDispatchHistory(limit=%d) queried %d, want %d", limit, db.limit, want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"time"
+
+	"github.com/synchthia/systera-api/database"
+	sts "github.com/synchthia/systera-api/status"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxEvidenceBlob - Max size of evidence blob
+const maxEvidenceBlob = 1 << 20
+
+func (s *grpcServer) AddEvidence(ctx context.Context, e *pb.AddEvidenceRequest) (*pb.AddEvidenceResponse, error) {
+	entry := e.GetEntry()
+	if (entry.GetPunishmentId() == 0) == (entry.GetReportId() == 0) {
+		return &pb.AddEvidenceResponse{}, status.Error(codes.InvalidArgument, "either punishment_id or report_id is required")
+	}
+	if entry.Url == "" && len(e.Blob) == 0 {
+		return &pb.AddEvidenceResponse{}, status.Error(codes.InvalidArgument, "url or blob is required")
+	}
+	if len(e.Blob) > maxEvidenceBlob {
+		return &pb.AddEvidenceResponse{}, status.Errorf(codes.InvalidArgument, "blob exceeds %d bytes", maxEvidenceBlob)
+	}
+
+	evidence := &database.Evidences{
+		PunishmentsID: uint(entry.PunishmentId),
+		ReportID:      uint(entry.ReportId),
+		URL:           entry.Url,
+		Note:          entry.Note,
+		UploaderUUID:  entry.Uploader.GetUuid(),
+		UploaderName:  entry.Uploader.GetName(),
+		Date:          time.Now(),
+		ContentType:   entry.ContentType,
+	}
+	if err := s.db.AddEvidence(evidence, e.Blob); err != nil {
+		return &pb.AddEvidenceResponse{}, sts.Convert(err).Err()
+	}
+	return &pb.AddEvidenceResponse{Entry: evidence.ToProtobuf()}, nil
+}
+
+func (s *grpcServer) GetEvidenceBlob(ctx context.Context, e *pb.GetEvidenceBlobRequest) (*pb.GetEvidenceBlobResponse, error) {
+	evidence, blob, err := s.db.GetEvidenceBlob(uint(e.Id))
+	if err != nil {
+		return &pb.GetEvidenceBlobResponse{}, sts.Convert(err).Err()
+	}
+	return &pb.GetEvidenceBlobResponse{Entry: evidence.ToProtobuf(), Blob: blob}, nil
+}
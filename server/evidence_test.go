@@ -0,0 +1,53 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/synchthia/systera-api/database"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAddEvidence(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		ctx := context.Background()
+		login(t, s, steve)
+		punish(t, s, steve, pb.PunishLevel_TEMPBAN, "grief", time.Now().Add(time.Hour))
+		bans, _ := s.GetPlayerPunish(ctx, &pb.GetPlayerPunishRequest{Uuid: steve.Uuid, FilterLevel: pb.PunishLevel_TEMPBAN})
+		id := bans.Entry[0].Id
+
+		_, err := s.AddEvidence(ctx, &pb.AddEvidenceRequest{Entry: &pb.EvidenceEntry{Url: "https://example.net"}})
+		wantCode(t, err, codes.InvalidArgument)
+		_, err = s.AddEvidence(ctx, &pb.AddEvidenceRequest{Entry: &pb.EvidenceEntry{PunishmentId: id, ReportId: 1, Url: "https://example.net"}})
+		wantCode(t, err, codes.InvalidArgument)
+		_, err = s.AddEvidence(ctx, &pb.AddEvidenceRequest{Entry: &pb.EvidenceEntry{PunishmentId: id}})
+		wantCode(t, err, codes.InvalidArgument)
+		_, err = s.AddEvidence(ctx, &pb.AddEvidenceRequest{Entry: &pb.EvidenceEntry{PunishmentId: id}, Blob: make([]byte, maxEvidenceBlob+1)})
+		wantCode(t, err, codes.InvalidArgument)
+
+		// No EVIDENCE_STORE: blobs are rejected on every backend
+		_, err = s.AddEvidence(ctx, &pb.AddEvidenceRequest{Entry: &pb.EvidenceEntry{PunishmentId: id}, Blob: []byte("png")})
+		wantCode(t, err, codes.FailedPrecondition)
+		_, err = s.AddEvidence(ctx, &pb.AddEvidenceRequest{Entry: &pb.EvidenceEntry{PunishmentId: id + 100, Url: "https://example.net"}})
+		wantCode(t, err, codes.NotFound)
+
+		s.db.(interface{ SetObjectStore(database.ObjectStore) }).SetObjectStore(database.NewMemoryObjectStore())
+		res, err := s.AddEvidence(ctx, &pb.AddEvidenceRequest{
+			Entry: &pb.EvidenceEntry{PunishmentId: id, Uploader: admin, ContentType: "image/png"},
+			Blob:  []byte("png"),
+		})
+		if err != nil || res.Entry.Id == 0 || res.Entry.BlobSize != 3 {
+			t.Fatalf("AddEvidence(blob): %+v %v", res, err)
+		}
+
+		blob, err := s.GetEvidenceBlob(ctx, &pb.GetEvidenceBlobRequest{Id: res.Entry.Id})
+		if err != nil || !bytes.Equal(blob.Blob, []byte("png")) || blob.Entry.Uploader.GetName() != "Admin" {
+			t.Fatalf("GetEvidenceBlob: %+v %v", blob, err)
+		}
+		_, err = s.GetEvidenceBlob(ctx, &pb.GetEvidenceBlobRequest{Id: res.Entry.Id + 100})
+		wantCode(t, err, codes.NotFound)
+	})
+}
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/database"
+	"github.com/synchthia/systera-api/metrics"
+	sts "github.com/synchthia/systera-api/status"
+	"github.com/synchthia/systera-api/stream"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// route - REST route mapped to Systera RPC
+// Path parameters, query parameters and JSON body are merged into the RPC request.
+type route struct {
+	Method  string
+	Pattern string
+	RPC     string
+}
+
+// gatewayRoutes - REST routes for every unary Systera RPC
+var gatewayRoutes = []route{
+	// System
+	{"POST", "/announce", "Announce"},
+	{"POST", "/dispatch", "Dispatch"},
+	{"POST", "/dispatch/{id}/results", "ReportDispatchResult"},
+	{"GET", "/dispatch", "GetDispatchHistory"},
+	{"PUT", "/servers/{entry.name}", "ServerHeartbeat"},
+	{"DELETE", "/servers/{name}", "UnregisterServer"},
+	{"GET", "/servers", "ListServers"},
+	{"GET", "/servers/{name}", "GetServer"},
+	{"GET", "/server-groups", "FetchServerGroups"},
+	{"PUT", "/server-groups/{group.name}", "SetServerGroup"},
+	{"DELETE", "/server-groups/{name}", "RemoveServerGroup"},
+	{"POST", "/announcements", "CreateScheduledAnnouncement"},
+	{"GET", "/announcements", "ListScheduledAnnouncements"},
+	{"DELETE", "/announcements/{id}", "DeleteScheduledAnnouncement"},
+
+	// Stream journal
+	{"GET", "/events/{server_name}", "ReplayEvents"},
+	{"POST", "/events/{server_name}/ack", "AckEvents"},
+
+	// Chat
+	{"POST", "/chat", "Chat"},
+	{"POST", "/players/{uuid}/ignores", "AddChatIgnore"},
+	{"DELETE", "/players/{uuid}/ignores", "RemoveChatIgnore"},
+
+	// Player
+	{"GET", "/identities/{name}", "GetPlayerIdentityByName"},
+	{"GET", "/identities/{name}/profile", "FetchPlayerProfileByName"},
+	{"POST", "/players/{uuid}/init", "InitPlayerProfile"},
+	{"GET", "/players/{uuid}", "FetchPlayerProfile"},
+	{"PUT", "/players/{uuid}/groups", "SetPlayerGroups"},
+	{"PUT", "/players/{uuid}/server", "SetPlayerServer"},
+	{"DELETE", "/players/{uuid}/server", "RemovePlayerServer"},
+	{"POST", "/servers/{server_name}/heartbeat", "Heartbeat"},
+	{"GET", "/online", "ListOnlinePlayers"},
+	{"GET", "/players/{uuid}/names", "GetNameHistory"},
+	{"GET", "/players/{uuid}/playtime", "GetPlaytime"},
+	{"GET", "/players/{uuid}/sessions", "GetSessionHistory"},
+	{"GET", "/playtime/leaderboard", "GetPlaytimeLeaderboard"},
+	{"PUT", "/players/{uuid}/settings", "SetPlayerSettings"},
+	{"GET", "/players/{player_uuid}/alts", "AltLookup"},
+	{"POST", "/players/{entry.uuid}/notes", "AddPlayerNote"},
+	{"GET", "/players/{uuid}/notes", "ListPlayerNotes"},
+	{"DELETE", "/notes/{id}", "DeletePlayerNote"},
+
+	// Punishment
+	{"GET", "/players/{uuid}/punishments", "GetPlayerPunish"},
+	{"POST", "/punishments", "SetPlayerPunish"},
+	{"GET", "/punishments", "SearchPunishments"},
+	{"POST", "/unban", "UnBan"},
+	{"POST", "/punishments/{punishment_id}/appeals", "SubmitAppeal"},
+	{"GET", "/appeals", "ListAppeals"},
+	{"POST", "/appeals/{id}/review", "ReviewAppeal"},
+
+	// Report
+	{"POST", "/reports", "Report"},
+	{"GET", "/reports", "GetReports"},
+
+	// Evidence
+	{"POST", "/evidence", "AddEvidence"},
+	{"GET", "/evidence/{id}", "GetEvidenceBlob"},
+
+	// Group
+	{"GET", "/groups", "FetchGroups"},
+	{"POST", "/groups", "CreateGroup"},
+	{"PUT", "/groups/{group_entry.group_name}", "UpdateGroup"},
+	{"DELETE", "/groups/{group_name}", "RemoveGroup"},
+	{"POST", "/groups/{group_name}/permissions", "AddPermission"},
+	{"DELETE", "/groups/{group_name}/permissions", "RemovePermission"},
+}
+
+// gatewayToken - Token required on every gateway route (empty: no authentication)
+var gatewayToken string
+
+// SetGatewayToken - Require "Authorization: Bearer <token>" on gateway routes
+func SetGatewayToken(token string) {
+	gatewayToken = token
+}
+
+// gatewayAuthorized - Request carries the gateway token (or staff token, which implies it)
+func gatewayAuthorized(r *http.Request) bool {
+	if gatewayToken == "" {
+		return true
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if staffToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(staffToken)) == 1 {
+		return true
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(gatewayToken)) == 1
+}
+
+var gatewayMarshaler = &runtime.JSONPb{
+	MarshalOptions: protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	},
+	UnmarshalOptions: protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+	},
+}
+
+// NewHTTPGateway - REST/JSON front end for Systera service
+func NewHTTPGateway(db database.Storage, stream *stream.Stream) http.Handler {
+	s := NewServer(db, stream)
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, gatewayMarshaler),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+	)
+
+	for _, rt := range gatewayRoutes {
+		if err := mux.HandlePath(rt.Method, rt.Pattern, s.gatewayHandler(mux, rt)); err != nil {
+			logrus.Fatalf("[Gateway] Invalid route %s %s: %s", rt.Method, rt.Pattern, err)
+		}
+	}
+
+	doc := openAPIDocument(gatewayRoutes)
+	mux.HandlePath("GET", "/openapi.json", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(doc)
+	})
+
+	return mux
+}
+
+// gatewayHandler - Decode HTTP request, call RPC method and encode response
+func (s *grpcServer) gatewayHandler(mux *runtime.ServeMux, rt route) runtime.HandlerFunc {
+	method := reflect.ValueOf(s).MethodByName(rt.RPC)
+	if !method.IsValid() {
+		logrus.Fatalf("[Gateway] Unknown RPC: %s", rt.RPC)
+	}
+	requestType := method.Type().In(1).Elem()
+	fullMethod := "/" + pb.Systera_ServiceDesc.ServiceName + "/" + rt.RPC
+
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		begin := time.Now()
+
+		if !gatewayAuthorized(r) {
+			runtime.HTTPError(r.Context(), mux, gatewayMarshaler, w, r, status.Error(codes.Unauthenticated, "missing or invalid gateway token"))
+			return
+		}
+
+		ctx, err := runtime.AnnotateIncomingContext(r.Context(), mux, r, fullMethod, runtime.WithHTTPPathPattern(rt.Pattern))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, gatewayMarshaler, w, r, err)
+			return
+		}
+
+		in := reflect.New(requestType).Interface().(proto.Message)
+		if err := decodeRequest(r, in, pathParams); err != nil {
+			runtime.HTTPError(ctx, mux, gatewayMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		result := method.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(in)})
+		if err, _ := result[1].Interface().(error); err != nil {
+			err = toStatus(err).Err()
+			metrics.ObserveCall(fullMethod, begin, err)
+			runtime.HTTPError(ctx, mux, gatewayMarshaler, w, r, err)
+			return
+		}
+		metrics.ObserveCall(fullMethod, begin, nil)
+
+		runtime.ForwardResponseMessage(ctx, mux, gatewayMarshaler, w, r, result[0].Interface().(proto.Message))
+	}
+}
+
+// decodeRequest - Merge body, query and path parameters into request message
+func decodeRequest(r *http.Request, in proto.Message, pathParams map[string]string) error {
+	if r.Method != http.MethodGet && r.Method != http.MethodDelete {
+		if err := gatewayMarshaler.NewDecoder(r.Body).Decode(in); err != nil && err != io.EOF {
+			return err
+		}
+	}
+
+	var seqs [][]string
+	for k := range pathParams {
+		seqs = append(seqs, strings.Split(k, "."))
+	}
+	if err := runtime.PopulateQueryParameters(in, r.URL.Query(), utilities.NewDoubleArray(seqs)); err != nil {
+		return err
+	}
+
+	for k, v := range pathParams {
+		if err := runtime.PopulateFieldFromPath(in, k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	// Routing errors (404 / 405) carry their own HTTP status
+	var httpErr *runtime.HTTPStatusError
+	if errors.As(err, &httpErr) {
+		runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, err)
+		return
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, toStatus(err).Err())
+}
+
+// toStatus - Convert handler error to grpc status (decides HTTP status code)
+func toStatus(err error) *status.Status {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return status.New(codes.NotFound, err.Error())
+	}
+	return sts.Convert(err)
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/synchthia/systera-api/database"
+	"github.com/synchthia/systera-api/stream"
+)
+
+// withGatewayToken - Configure gateway token for test
+func withGatewayToken(t *testing.T, token string) {
+	prev := gatewayToken
+	SetGatewayToken(token)
+	t.Cleanup(func() { SetGatewayToken(prev) })
+}
+
+// gatewayGet - GET path from gateway, return status code
+func gatewayGet(t *testing.T, h http.Handler, path, token string) int {
+	t.Helper()
+
+	r := httptest.NewRequest("GET", path, nil)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestGatewayAuth(t *testing.T) {
+	h := NewHTTPGateway(database.NewMemory(), stream.New(stream.NewInProcessPublisher(), nil))
+
+	withGatewayToken(t, "")
+	withStaffToken(t, "")
+	if code := gatewayGet(t, h, "/servers", ""); code != http.StatusOK {
+		t.Fatalf("no gateway token configured: %d", code)
+	}
+
+	withGatewayToken(t, "gw")
+	withStaffToken(t, "staff")
+	for token, want := range map[string]int{
+		"":      http.StatusUnauthorized,
+		"wrong": http.StatusUnauthorized,
+		"gw":    http.StatusOK,
+		"staff": http.StatusOK,
+	} {
+		if code := gatewayGet(t, h, "/servers", token); code != want {
+			t.Errorf("GET /servers with %q: %d, want %d", token, code, want)
+		}
+	}
+
+	if code := gatewayGet(t, h, "/openapi.json", ""); code != http.StatusOK {
+		t.Fatalf("GET /openapi.json: %d", code)
+	}
+
+	// Authorization header reaches handlers as staff scope
+	if code := gatewayGet(t, h, "/players/"+steve.Uuid+"/notes", "gw"); code != http.StatusForbidden {
+		t.Fatalf("notes with gateway token: %d", code)
+	}
+	if code := gatewayGet(t, h, "/players/"+steve.Uuid+"/notes", "staff"); code != http.StatusOK {
+		t.Fatalf("notes with staff token: %d", code)
+	}
+}
@@ -2,13 +2,12 @@ package server
 
 import (
 	"github.com/synchthia/systera-api/database"
-	"github.com/synchthia/systera-api/stream"
 	pb "github.com/synchthia/systera-api/systerapb"
 	"golang.org/x/net/context"
 )
 
 func (s *grpcServer) FetchGroups(ctx context.Context, e *pb.FetchGroupsRequest) (*pb.FetchGroupsResponse, error) {
-	groups, err := s.mysql.GetAllGroup()
+	groups, err := s.db.GetAllGroup()
 
 	var allGroups []*pb.GroupEntry
 	for _, group := range groups {
@@ -35,18 +34,18 @@ func (s *grpcServer) CreateGroup(ctx context.Context, e *pb.CreateGroupRequest)
 	}
 	d.Permissions = dbPerms
 
-	err := s.mysql.CreateGroup(d)
+	err := s.db.CreateGroup(d)
 	if err != nil {
 		return &pb.Empty{}, err
 	}
 
-	stream.PublishGroup(d.ToProtobuf())
+	s.stream.PublishGroup(d.ToProtobuf())
 
 	return &pb.Empty{}, err
 }
 
 func (s *grpcServer) RemoveGroup(ctx context.Context, e *pb.RemoveGroupRequest) (*pb.Empty, error) {
-	err := s.mysql.RemoveGroup(e.GroupName)
+	err := s.db.RemoveGroup(e.GroupName)
 	return &pb.Empty{}, err
 }
 
@@ -67,32 +66,32 @@ func (s *grpcServer) UpdateGroup(ctx context.Context, e *pb.UpdateGroupRequest)
 	}
 	d.Permissions = dbPerms
 
-	err := s.mysql.UpdateGroup(d)
+	err := s.db.UpdateGroup(d)
 	if err != nil {
 		return &pb.Empty{}, err
 	}
 
-	stream.PublishGroup(d.ToProtobuf())
+	s.stream.PublishGroup(d.ToProtobuf())
 
 	return &pb.Empty{}, err
 }
 
 func (s *grpcServer) AddPermission(ctx context.Context, e *pb.AddPermissionRequest) (*pb.Empty, error) {
-	if err := s.mysql.AddPermission(e.GroupName, e.Target, e.Permissions); err != nil {
+	if err := s.db.AddPermission(e.GroupName, e.Target, e.Permissions); err != nil {
 		return &pb.Empty{}, err
 	}
-	data, err := s.mysql.GetGroupData(e.GroupName)
-	stream.PublishPerms(e.Target, data.ToProtobuf())
+	data, err := s.db.GetGroupData(e.GroupName)
+	s.stream.PublishPerms(e.Target, data.ToProtobuf())
 
 	return &pb.Empty{}, err
 }
 
 func (s *grpcServer) RemovePermission(ctx context.Context, e *pb.RemovePermissionRequest) (*pb.Empty, error) {
-	if err := s.mysql.RemovePermission(e.GroupName, e.Target, e.Permissions); err != nil {
+	if err := s.db.RemovePermission(e.GroupName, e.Target, e.Permissions); err != nil {
 		return &pb.Empty{}, err
 	}
-	data, err := s.mysql.GetGroupData(e.GroupName)
-	stream.PublishPerms(e.Target, data.ToProtobuf())
+	data, err := s.db.GetGroupData(e.GroupName)
+	s.stream.PublishPerms(e.Target, data.ToProtobuf())
 
 	return &pb.Empty{}, err
 }
@@ -6,6 +6,7 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/synchthia/systera-api/database"
+	"github.com/synchthia/systera-api/metrics"
 	"github.com/synchthia/systera-api/stream"
 	"github.com/synchthia/systera-api/systerapb"
 	pb "github.com/synchthia/systera-api/systerapb"
@@ -47,36 +48,44 @@ type Server interface {
 }
 
 type grpcServer struct {
-	server Server
-	mu     sync.RWMutex
-	mysql  *database.Mysql
+	server  Server
+	mu      sync.RWMutex
+	db      database.Storage
+	stream  *stream.Stream
+	targets *targetResolver
 }
 
-func NewServer(mysql *database.Mysql) *grpcServer {
+func NewServer(db database.Storage, stream *stream.Stream) *grpcServer {
 	return &grpcServer{
-		mysql: mysql,
+		db:      db,
+		stream:  stream,
+		targets: &targetResolver{db: db},
 	}
 }
 
-func NewGRPCServer(mysql *database.Mysql) *grpc.Server {
-	server := grpc.NewServer()
+func NewGRPCServer(db database.Storage, stream *stream.Stream) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor()),
+	)
 	reflection.Register(server)
-	pb.RegisterSysteraServer(server, NewServer(mysql))
+	pb.RegisterSysteraServer(server, NewServer(db, stream))
 	return server
 }
 
-func (s *grpcServer) Announce(ctx context.Context, e *pb.AnnounceRequest) (*pb.Empty, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	err := stream.PublishAnnounce(e.Target, e.Message)
-	return &pb.Empty{}, err
-}
+func (s *grpcServer) Announce(ctx context.Context, e *pb.AnnounceRequest) (*pb.AnnounceResponse, error) {
+	targets, err := s.targets.resolve(e.Target)
+	if err != nil {
+		return &pb.AnnounceResponse{}, err
+	}
 
-func (s *grpcServer) Dispatch(ctx context.Context, e *pb.DispatchRequest) (*pb.Empty, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	err := stream.PublishCommand(e.Target, e.Cmd)
-	return &pb.Empty{}, err
+	for _, target := range targets {
+		if err := s.stream.PublishAnnounce(target, e.Message); err != nil {
+			return &pb.AnnounceResponse{}, err
+		}
+	}
+	return &pb.AnnounceResponse{Targets: targets}, nil
 }
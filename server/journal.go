@@ -0,0 +1,33 @@
+package server
+
+import (
+	"github.com/synchthia/systera-api/stream"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *grpcServer) ReplayEvents(ctx context.Context, e *pb.ReplayEventsRequest) (*pb.ReplayEventsResponse, error) {
+	if e.ServerName == "" {
+		return &pb.ReplayEventsResponse{}, status.Error(codes.InvalidArgument, "server_name is required")
+	}
+
+	events, err := s.stream.Replay(e.ServerName, e.Topics, int(e.Limit))
+	if err == stream.ErrJournalDisabled {
+		return &pb.ReplayEventsResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &pb.ReplayEventsResponse{Events: events}, err
+}
+
+func (s *grpcServer) AckEvents(ctx context.Context, e *pb.AckEventsRequest) (*pb.Empty, error) {
+	if e.ServerName == "" || e.Channel == "" {
+		return &pb.Empty{}, status.Error(codes.InvalidArgument, "server_name and channel are required")
+	}
+
+	err := s.stream.Ack(e.ServerName, e.Channel, e.Ids)
+	if err == stream.ErrJournalDisabled {
+		return &pb.Empty{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &pb.Empty{}, err
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"bytes"
+	"text/template"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/database"
+)
+
+// DefaultKickMessage - Kick message for banned players on login
+const DefaultKickMessage = `You are banned from this server.
+
+Reason: {{.Reason}}
+Expires: {{if .Permanent}}Never{{else}}{{.Expire.Format "2006/01/02 15:04:05 MST"}}{{end}}
+Banned by: {{.Punisher}}`
+
+var (
+	defaultKickTemplate = template.Must(template.New("kick").Parse(DefaultKickMessage))
+	kickTemplate        = defaultKickTemplate
+)
+
+// KickMessage - Fields available in kick message template
+type KickMessage struct {
+	Level     string
+	Reason    string
+	Punisher  string
+	Date      time.Time
+	Expire    time.Time
+	Permanent bool
+}
+
+// SetKickMessage - Replace kick message template (text/template with KickMessage fields)
+func SetKickMessage(text string) error {
+	t, err := template.New("kick").Parse(text)
+	if err != nil {
+		return err
+	}
+	kickTemplate = t
+	return nil
+}
+
+// renderKickMessage - Kick message for ban (default template is used if rendering failed)
+func renderKickMessage(ban *database.Punishments) string {
+	data := KickMessage{
+		Level:     ban.Level.String(),
+		Reason:    ban.Reason,
+		Punisher:  ban.PunisherPlayerName,
+		Date:      ban.Date,
+		Expire:    ban.Expire,
+		Permanent: ban.Level == database.PERMBAN || ban.Permanent(),
+	}
+
+	var buf bytes.Buffer
+	if err := kickTemplate.Execute(&buf, data); err != nil {
+		logrus.WithError(err).Errorf("[Player] Failed to render kick message")
+
+		buf.Reset()
+		defaultKickTemplate.Execute(&buf, data)
+	}
+	return buf.String()
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/database"
+	sts "github.com/synchthia/systera-api/status"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errStaffScope = status.Error(codes.PermissionDenied, "staff scope is required")
+
+func (s *grpcServer) AddPlayerNote(ctx context.Context, e *pb.AddPlayerNoteRequest) (*pb.AddPlayerNoteResponse, error) {
+	if !hasStaffScope(ctx) {
+		return &pb.AddPlayerNoteResponse{}, errStaffScope
+	}
+
+	entry := e.GetEntry()
+	if entry.GetUuid() == "" || entry.GetMessage() == "" {
+		return &pb.AddPlayerNoteResponse{}, status.Error(codes.InvalidArgument, "uuid and message are required")
+	}
+
+	note := &database.PlayerNotes{
+		PlayerUUID: entry.Uuid,
+		Message:    entry.Message,
+		Severity:   database.NoteSeverity(entry.Severity),
+		AuthorUUID: entry.Author.GetUuid(),
+		AuthorName: entry.Author.GetName(),
+		CreatedAt:  time.Now(),
+	}
+	if entry.ExpiresAt > 0 {
+		expires := time.UnixMilli(entry.ExpiresAt)
+		note.ExpiresAt = &expires
+	}
+
+	if err := s.db.AddPlayerNote(note); err != nil {
+		return &pb.AddPlayerNoteResponse{}, err
+	}
+	return &pb.AddPlayerNoteResponse{Entry: note.ToProtobuf()}, nil
+}
+
+func (s *grpcServer) ListPlayerNotes(ctx context.Context, e *pb.ListPlayerNotesRequest) (*pb.ListPlayerNotesResponse, error) {
+	if !hasStaffScope(ctx) {
+		return &pb.ListPlayerNotesResponse{}, errStaffScope
+	}
+
+	notes, err := s.db.GetPlayerNotes(e.Uuid, e.IncludeExpired)
+
+	var entries []*pb.PlayerNote
+	for _, n := range notes {
+		entries = append(entries, n.ToProtobuf())
+	}
+	return &pb.ListPlayerNotesResponse{Entries: entries}, err
+}
+
+func (s *grpcServer) DeletePlayerNote(ctx context.Context, e *pb.DeletePlayerNoteRequest) (*pb.Empty, error) {
+	if !hasStaffScope(ctx) {
+		return &pb.Empty{}, errStaffScope
+	}
+
+	if err := s.db.DeletePlayerNote(uint(e.Id)); err != nil {
+		return &pb.Empty{}, sts.Convert(err).Err()
+	}
+	return &pb.Empty{}, nil
+}
+
+// staffNoteCount - Active note count for staff clients (0 for others)
+// Failure to count is logged only, so profile lookup (ex. on login) doesn't fail on it.
+func (s *grpcServer) staffNoteCount(ctx context.Context, playerUUID string) int32 {
+	if playerUUID == "" || !hasStaffScope(ctx) {
+		return 0
+	}
+	count, err := s.db.CountPlayerNotes(playerUUID)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Note] Failed to count notes of %s", playerUUID)
+		return 0
+	}
+	return int32(count)
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/synchthia/systera-api/database"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// withStaffToken - Configure staff token for test
+func withStaffToken(t *testing.T, token string) {
+	prev := staffToken
+	SetStaffToken(token)
+	t.Cleanup(func() { SetStaffToken(prev) })
+}
+
+// bearer - Incoming context carrying Authorization header
+func bearer(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestStaffScope(t *testing.T) {
+	withStaffToken(t, "")
+	if hasStaffScope(bearer("")) || hasStaffScope(context.Background()) {
+		t.Fatal("staff scope granted without STAFF_TOKEN")
+	}
+
+	withStaffToken(t, "tok")
+	if hasStaffScope(context.Background()) || hasStaffScope(bearer("wrong")) {
+		t.Fatal("staff scope granted without matching token")
+	}
+	if !hasStaffScope(bearer("tok")) {
+		t.Fatal("staff scope denied with matching token")
+	}
+}
+
+func TestPlayerNotes(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		login(t, s, steve)
+		req := &pb.AddPlayerNoteRequest{Entry: &pb.PlayerNote{Uuid: steve.Uuid, Message: "watch chat", Severity: pb.NoteSeverity_NOTE_WATCH, Author: admin}}
+
+		// Fail closed when no token is configured
+		withStaffToken(t, "")
+		_, err := s.AddPlayerNote(bearer(""), req)
+		wantCode(t, err, codes.PermissionDenied)
+
+		withStaffToken(t, "tok")
+		_, err = s.AddPlayerNote(context.Background(), req)
+		wantCode(t, err, codes.PermissionDenied)
+		_, err = s.ListPlayerNotes(bearer("wrong"), &pb.ListPlayerNotesRequest{Uuid: steve.Uuid})
+		wantCode(t, err, codes.PermissionDenied)
+
+		ctx := bearer("tok")
+		_, err = s.AddPlayerNote(ctx, &pb.AddPlayerNoteRequest{Entry: &pb.PlayerNote{Uuid: steve.Uuid}})
+		wantCode(t, err, codes.InvalidArgument)
+
+		res, err := s.AddPlayerNote(ctx, req)
+		if err != nil || res.Entry.Id == 0 || res.Entry.Author.GetName() != "Admin" {
+			t.Fatalf("AddPlayerNote: %+v %v", res, err)
+		}
+
+		list, err := s.ListPlayerNotes(ctx, &pb.ListPlayerNotesRequest{Uuid: steve.Uuid})
+		if err != nil || len(list.Entries) != 1 || list.Entries[0].Severity != pb.NoteSeverity_NOTE_WATCH {
+			t.Fatalf("ListPlayerNotes: %+v %v", list, err)
+		}
+
+		// Note count is only disclosed to staff
+		profile, err := s.FetchPlayerProfile(ctx, &pb.FetchPlayerProfileRequest{Uuid: steve.Uuid})
+		if err != nil || profile.StaffNoteCount != 1 {
+			t.Fatalf("FetchPlayerProfile(staff): %+v %v", profile, err)
+		}
+		profile, err = s.FetchPlayerProfile(context.Background(), &pb.FetchPlayerProfileRequest{Uuid: steve.Uuid})
+		if err != nil || profile.StaffNoteCount != 0 {
+			t.Fatalf("FetchPlayerProfile: %+v %v", profile, err)
+		}
+
+		_, err = s.DeletePlayerNote(context.Background(), &pb.DeletePlayerNoteRequest{Id: res.Entry.Id})
+		wantCode(t, err, codes.PermissionDenied)
+		if _, err := s.DeletePlayerNote(ctx, &pb.DeletePlayerNoteRequest{Id: res.Entry.Id}); err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.DeletePlayerNote(ctx, &pb.DeletePlayerNoteRequest{Id: res.Entry.Id})
+		wantCode(t, err, codes.NotFound)
+	})
+}
+
+// noteCountErrorStorage - CountPlayerNotes always fails
+type noteCountErrorStorage struct {
+	database.Storage
+}
+
+func (s *noteCountErrorStorage) CountPlayerNotes(playerUUID string) (int64, error) {
+	return 0, errors.New("count failed")
+}
+
+func TestFetchPlayerProfileNoteCountError(t *testing.T) {
+	withStaffToken(t, "tok")
+	s := newTestServer(&noteCountErrorStorage{Storage: database.NewMemory()})
+	login(t, s, steve)
+
+	ctx := bearer("tok")
+	profile, err := s.FetchPlayerProfile(ctx, &pb.FetchPlayerProfileRequest{Uuid: steve.Uuid})
+	if err != nil || profile.Entry.GetUuid() != steve.Uuid || profile.StaffNoteCount != 0 {
+		t.Fatalf("FetchPlayerProfile: %+v %v", profile, err)
+	}
+	profile, err = s.FetchPlayerProfileByName(ctx, &pb.FetchPlayerProfileByNameRequest{Name: steve.Name})
+	if err != nil || profile.Entry.GetUuid() != steve.Uuid || profile.StaffNoteCount != 0 {
+		t.Fatalf("FetchPlayerProfileByName: %+v %v", profile, err)
+	}
+}
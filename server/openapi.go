@@ -0,0 +1,196 @@
+package server
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	pb "github.com/synchthia/systera-api/systerapb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var pathParamPattern = regexp.MustCompile(`\{([^}=]+)\}`)
+
+// openAPIDocument - Build OpenAPI 3 document from gateway routes and proto descriptors
+func openAPIDocument(routes []route) []byte {
+	service := pb.File_systera_proto.Services().ByName("Systera")
+	paths := make(map[string]map[string]interface{})
+	schemas := map[string]interface{}{
+		"Status": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"code":    map[string]interface{}{"type": "integer", "format": "int32"},
+				"message": map[string]interface{}{"type": "string"},
+				"details": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "object"}},
+			},
+		},
+	}
+
+	for _, rt := range routes {
+		method := service.Methods().ByName(protoreflect.Name(rt.RPC))
+		if method == nil {
+			continue
+		}
+		addSchema(schemas, method.Input())
+		addSchema(schemas, method.Output())
+
+		var parameters []interface{}
+		inPath := make(map[string]bool)
+		for _, m := range pathParamPattern.FindAllStringSubmatch(rt.Pattern, -1) {
+			inPath[m[1]] = true
+			parameters = append(parameters, map[string]interface{}{
+				"name":     m[1],
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]interface{}{"type": "string"},
+			})
+		}
+
+		op := map[string]interface{}{
+			"operationId": rt.RPC,
+			"tags":        []string{"Systera"},
+			"responses": map[string]interface{}{
+				"200": map[string]interface{}{
+					"description": "OK",
+					"content":     jsonContent(schemaRef(method.Output())),
+				},
+				"default": map[string]interface{}{
+					"description": "Error",
+					"content":     jsonContent(map[string]interface{}{"$ref": "#/components/schemas/Status"}),
+				},
+			},
+		}
+
+		if rt.Method == "GET" || rt.Method == "DELETE" {
+			fields := method.Input().Fields()
+			for i := 0; i < fields.Len(); i++ {
+				fd := fields.Get(i)
+				if inPath[string(fd.Name())] || fd.Kind() == protoreflect.MessageKind {
+					continue
+				}
+				parameters = append(parameters, map[string]interface{}{
+					"name":   string(fd.Name()),
+					"in":     "query",
+					"schema": fieldSchema(fd),
+				})
+			}
+		} else {
+			op["requestBody"] = map[string]interface{}{
+				"content": jsonContent(schemaRef(method.Input())),
+			}
+		}
+
+		if len(parameters) != 0 {
+			op["parameters"] = parameters
+		}
+
+		if paths[rt.Pattern] == nil {
+			paths[rt.Pattern] = make(map[string]interface{})
+		}
+		paths[rt.Pattern][strings.ToLower(rt.Method)] = op
+	}
+
+	doc := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "Systera API",
+			"version": "1.0.0",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+		},
+	}
+
+	b, _ := json.MarshalIndent(doc, "", "  ")
+	return b
+}
+
+func jsonContent(schema interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": schema},
+	}
+}
+
+func schemaName(md protoreflect.MessageDescriptor) string {
+	return strings.TrimPrefix(string(md.FullName()), string(md.ParentFile().Package())+".")
+}
+
+func schemaRef(md protoreflect.MessageDescriptor) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + schemaName(md)}
+}
+
+// addSchema - Add message (and referenced messages) schema
+func addSchema(schemas map[string]interface{}, md protoreflect.MessageDescriptor) {
+	name := schemaName(md)
+	if _, ok := schemas[name]; ok {
+		return
+	}
+
+	properties := make(map[string]interface{})
+	schemas[name] = map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		properties[string(fd.Name())] = fieldSchema(fd)
+
+		if fd.IsMap() {
+			if v := fd.MapValue(); v.Kind() == protoreflect.MessageKind {
+				addSchema(schemas, v.Message())
+			}
+		} else if fd.Kind() == protoreflect.MessageKind {
+			addSchema(schemas, fd.Message())
+		}
+	}
+}
+
+func fieldSchema(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	if fd.IsMap() {
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": singularSchema(fd.MapValue()),
+		}
+	}
+	if fd.IsList() {
+		return map[string]interface{}{
+			"type":  "array",
+			"items": singularSchema(fd),
+		}
+	}
+	return singularSchema(fd)
+}
+
+func singularSchema(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+	case protoreflect.StringKind:
+		return map[string]interface{}{"type": "string"}
+	case protoreflect.BytesKind:
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson encodes 64-bit integers as strings
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return map[string]interface{}{"type": "number"}
+	case protoreflect.EnumKind:
+		var values []string
+		ev := fd.Enum().Values()
+		for i := 0; i < ev.Len(); i++ {
+			values = append(values, string(ev.Get(i).Name()))
+		}
+		return map[string]interface{}{"type": "string", "enum": values}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return schemaRef(fd.Message())
+	default:
+		return map[string]interface{}{}
+	}
+}
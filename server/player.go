@@ -3,13 +3,12 @@ package server
 import (
 	"github.com/synchthia/systera-api/database"
 	sts "github.com/synchthia/systera-api/status"
-	"github.com/synchthia/systera-api/stream"
 	pb "github.com/synchthia/systera-api/systerapb"
 	"golang.org/x/net/context"
 )
 
 func (s *grpcServer) GetPlayerIdentityByName(ctx context.Context, e *pb.GetPlayerIdentityByNameRequest) (*pb.GetPlayerIdentityByNameResponse, error) {
-	r, err := s.mysql.GetIdentityByName(e.Name)
+	r, err := s.db.GetIdentityByName(e.Name)
 
 	if err != nil {
 		if err == sts.ErrPlayerNotFound.Error {
@@ -33,37 +32,62 @@ func (s *grpcServer) GetPlayerIdentityByName(ctx context.Context, e *pb.GetPlaye
 }
 
 func (s *grpcServer) InitPlayerProfile(ctx context.Context, e *pb.InitPlayerProfileRequest) (*pb.InitPlayerProfileResponse, error) {
-	r, err := s.mysql.InitPlayerProfile(e.Uuid, e.Name, e.IpAddress, e.Hostname)
-	return &pb.InitPlayerProfileResponse{Entry: r.ToProtobuf()}, err
+	r, err := s.db.InitPlayerProfile(e.Uuid, e.Name, e.IpAddress, e.Hostname)
+	if err != nil {
+		return &pb.InitPlayerProfileResponse{}, err
+	}
+
+	res := &pb.InitPlayerProfileResponse{
+		Entry:        r.Player.ToProtobuf(),
+		FirstJoin:    r.FirstJoin,
+		NameChanged:  r.NameChanged(),
+		PreviousName: r.PreviousName,
+	}
+	if r.Ban != nil {
+		res.Ban = r.Ban.Level.ToProtobuf()
+		res.ActiveBan = r.Ban.ToProtobuf()
+		res.KickMessage = renderKickMessage(r.Ban)
+	}
+	return res, nil
 }
 
 func (s *grpcServer) FetchPlayerProfile(ctx context.Context, e *pb.FetchPlayerProfileRequest) (*pb.FetchPlayerProfileResponse, error) {
-	playerData, err := s.mysql.FindPlayer(e.Uuid)
+	playerData, err := s.db.FindPlayer(e.Uuid)
+	if err != nil {
+		return &pb.FetchPlayerProfileResponse{Entry: playerData.ToProtobuf()}, err
+	}
+
 	return &pb.FetchPlayerProfileResponse{
-		Entry: playerData.ToProtobuf(),
-	}, err
+		Entry:          playerData.ToProtobuf(),
+		StaffNoteCount: s.staffNoteCount(ctx, playerData.UUID),
+	}, nil
 }
 
 func (s *grpcServer) FetchPlayerProfileByName(ctx context.Context, e *pb.FetchPlayerProfileByNameRequest) (*pb.FetchPlayerProfileResponse, error) {
-	playerData, err := s.mysql.FindPlayerByName(e.Name)
+	playerData, err := s.db.FindPlayerByName(e.Name)
+	if err != nil {
+		return &pb.FetchPlayerProfileResponse{Entry: playerData.ToProtobuf()}, err
+	}
+
 	return &pb.FetchPlayerProfileResponse{
-		Entry: playerData.ToProtobuf(),
-	}, err
+		Entry:          playerData.ToProtobuf(),
+		StaffNoteCount: s.staffNoteCount(ctx, playerData.UUID),
+	}, nil
 }
 
 func (s *grpcServer) SetPlayerGroups(ctx context.Context, e *pb.SetPlayerGroupsRequest) (*pb.Empty, error) {
-	if err := s.mysql.SetPlayerGroups(e.Uuid, e.Groups); err != nil {
+	if err := s.db.SetPlayerGroups(e.Uuid, e.Groups); err != nil {
 		return &pb.Empty{}, err
 	}
 
-	playerData, err := s.mysql.FindPlayer(e.Uuid)
+	playerData, err := s.db.FindPlayer(e.Uuid)
 
 	if err != nil {
 		return &pb.Empty{}, err
 	}
 
 	if playerData.CurrentServer != "" {
-		stream.PublishPlayerGroups(playerData.CurrentServer,
+		s.stream.PublishPlayerGroups(playerData.CurrentServer,
 			&pb.PlayerEntry{
 				Uuid:   e.Uuid,
 				Groups: e.Groups,
@@ -74,22 +98,22 @@ func (s *grpcServer) SetPlayerGroups(ctx context.Context, e *pb.SetPlayerGroupsR
 }
 
 func (s *grpcServer) SetPlayerServer(ctx context.Context, e *pb.SetPlayerServerRequest) (*pb.Empty, error) {
-	err := s.mysql.SetPlayerServer(false, e.Uuid, e.ServerName)
+	err := s.db.SetPlayerServer(false, e.Uuid, e.ServerName)
 	return &pb.Empty{}, err
 }
 
 func (s *grpcServer) RemovePlayerServer(ctx context.Context, e *pb.RemovePlayerServerRequest) (*pb.Empty, error) {
-	err := s.mysql.SetPlayerServer(true, e.Uuid, e.ServerName)
+	err := s.db.SetPlayerServer(true, e.Uuid, e.ServerName)
 	return &pb.Empty{}, err
 }
 
 func (s *grpcServer) SetPlayerSettings(ctx context.Context, e *pb.SetPlayerSettingsRequest) (*pb.Empty, error) {
-	err := s.mysql.SetPlayerSettings(e.Uuid, (&database.PlayerSettings{}).FromProtobuf(e.Settings))
+	err := s.db.SetPlayerSettings(e.Uuid, (&database.PlayerSettings{}).FromProtobuf(e.Settings))
 	return &pb.Empty{}, err
 }
 
 func (s *grpcServer) AltLookup(ctx context.Context, e *pb.AltLookupRequest) (*pb.AltLookupResponse, error) {
-	result, err := s.mysql.AltLookup(e.PlayerUuid)
+	result, err := s.db.AltLookup(e.PlayerUuid)
 	if err != nil {
 		return &pb.AltLookupResponse{}, err
 	}
@@ -115,3 +139,13 @@ func (s *grpcServer) AltLookup(ctx context.Context, e *pb.AltLookupRequest) (*pb
 
 	return &pb.AltLookupResponse{Entries: entry}, err
 }
+
+func (s *grpcServer) GetNameHistory(ctx context.Context, e *pb.GetNameHistoryRequest) (*pb.GetNameHistoryResponse, error) {
+	history, err := s.db.GetNameHistory(e.Uuid)
+
+	var entries []*pb.NameHistoryEntry
+	for _, h := range history {
+		entries = append(entries, h.ToProtobuf())
+	}
+	return &pb.GetNameHistoryResponse{Entries: entries}, err
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"time"
+
+	"github.com/synchthia/systera-api/database"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *grpcServer) Heartbeat(ctx context.Context, e *pb.HeartbeatRequest) (*pb.Empty, error) {
+	if e.ServerName == "" {
+		return &pb.Empty{}, status.Error(codes.InvalidArgument, "server_name is required")
+	}
+
+	ttl := time.Duration(e.Ttl) * time.Millisecond
+	if ttl <= 0 {
+		ttl = database.DefaultPresenceTTL
+	}
+
+	_, err := s.db.RenewPresence(e.ServerName, e.Uuids, time.Now().Add(ttl))
+	return &pb.Empty{}, err
+}
+
+func (s *grpcServer) ListOnlinePlayers(ctx context.Context, e *pb.ListOnlinePlayersRequest) (*pb.ListOnlinePlayersResponse, error) {
+	players, err := s.db.GetOnlinePlayers(e.ServerName)
+
+	var entries []*pb.OnlinePlayerEntry
+	for _, p := range players {
+		entries = append(entries, &pb.OnlinePlayerEntry{
+			Identity: &pb.PlayerIdentity{
+				Uuid: p.UUID,
+				Name: p.Name,
+			},
+			ServerName: p.CurrentServer,
+		})
+	}
+	return &pb.ListOnlinePlayersResponse{Entries: entries}, err
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+)
+
+// onlineOn - UUIDs online on server
+func onlineOn(t *testing.T, s *grpcServer, server string) []string {
+	t.Helper()
+
+	res, err := s.ListOnlinePlayers(context.Background(), &pb.ListOnlinePlayersRequest{ServerName: server})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var uuids []string
+	for _, e := range res.Entries {
+		uuids = append(uuids, e.Identity.Uuid)
+	}
+	return uuids
+}
+
+func TestServerDownClearsPresence(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		ctx := context.Background()
+		login(t, s, steve)
+		login(t, s, alex)
+		for _, name := range []string{"lobby", "skywars-1"} {
+			if _, err := s.ServerHeartbeat(ctx, &pb.ServerHeartbeatRequest{Entry: &pb.ServerEntry{Name: name}, Ttl: 1000}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		s.SetPlayerServer(ctx, &pb.SetPlayerServerRequest{Uuid: steve.Uuid, ServerName: "lobby"})
+		s.SetPlayerServer(ctx, &pb.SetPlayerServerRequest{Uuid: alex.Uuid, ServerName: "skywars-1"})
+
+		if _, err := s.UnregisterServer(ctx, &pb.UnregisterServerRequest{Name: "lobby"}); err != nil {
+			t.Fatal(err)
+		}
+		if online := onlineOn(t, s, "lobby"); len(online) != 0 {
+			t.Fatalf("online on unregistered server: %v", online)
+		}
+
+		// Registry expiry clears players whose presence lease is still valid
+		NewSweeper(s.db, s.stream).sweep(time.Now().Add(2 * time.Second))
+		if online := onlineOn(t, s, ""); len(online) != 0 {
+			t.Fatalf("online after server expired: %v", online)
+		}
+	})
+}
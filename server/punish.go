@@ -1,18 +1,22 @@
 package server
 
 import (
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/synchthia/systera-api/database"
-	"github.com/synchthia/systera-api/stream"
 	pb "github.com/synchthia/systera-api/systerapb"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *grpcServer) GetPlayerPunish(ctx context.Context, e *pb.GetPlayerPunishRequest) (*pb.GetPlayerPunishResponse, error) {
 	level := database.PunishLevel(e.FilterLevel)
-	entries, err := s.mysql.GetPlayerPunishment(e.Uuid, level, e.IncludeExpired)
+	entries, err := s.db.GetPlayerPunishment(e.Uuid, level, e.IncludeExpired)
 
 	var punishEntry []*pb.PunishEntry
 	for _, entry := range entries {
@@ -27,7 +31,7 @@ func (s *grpcServer) SetPlayerPunish(ctx context.Context, e *pb.SetPlayerPunishR
 	entry := e.Entry
 	level := database.PunishLevel(entry.Level)
 	if e.Force || entry.PunishedTo.Uuid == "" {
-		targetUUID, err := s.mysql.NameToUUID(entry.PunishedTo.Name)
+		targetUUID, err := s.db.NameToUUID(entry.PunishedTo.Name)
 		if err != nil {
 			logrus.WithError(err).Errorf("[MojangAPI] Failed Lookup Player UUID: %s", entry.PunishedTo.Name)
 			return &pb.SetPlayerPunishResponse{}, err
@@ -50,10 +54,10 @@ func (s *grpcServer) SetPlayerPunish(ctx context.Context, e *pb.SetPlayerPunishR
 		Name: entry.PunishedTo.Name,
 	}
 
-	success, result, err := s.mysql.SetPlayerPunishment(e.Force, from, to, level, entry.Reason, entry.Date, entry.Expire)
+	success, result, err := s.db.SetPlayerPunishment(e.Force, from, to, level, entry.Reason, entry.Date, entry.Expire)
 
 	if err == nil && success {
-		stream.PublishPunish(e.Remote, entry)
+		s.stream.PublishPunish(e.Remote, entry)
 	}
 
 	response := &pb.SetPlayerPunishResponse{
@@ -74,12 +78,82 @@ func (s *grpcServer) UnBan(ctx context.Context, e *pb.UnBanRequest) (*pb.UnBanRe
 	}
 
 	if targetUUID == "" {
-		if u, err := s.mysql.NameToUUID(e.Target.Name); err != nil {
+		if u, err := s.db.NameToUUID(e.Target.Name); err != nil {
 			return &pb.UnBanResponse{}, err
 		} else {
 			targetUUID = u
 		}
 	}
 
-	return &pb.UnBanResponse{}, s.mysql.UnBan(targetUUID)
+	return &pb.UnBanResponse{}, s.db.UnBan(targetUUID)
+}
+
+func (s *grpcServer) SearchPunishments(ctx context.Context, e *pb.SearchPunishmentsRequest) (*pb.SearchPunishmentsResponse, error) {
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	filter := database.PunishmentFilter{
+		Punisher:   e.Punisher,
+		Level:      database.PunishLevel(e.Level),
+		Keyword:    e.Keyword,
+		ActiveOnly: e.ActiveOnly,
+	}
+	if e.From > 0 {
+		filter.From = time.UnixMilli(e.From)
+	}
+	if e.To > 0 {
+		filter.To = time.UnixMilli(e.To)
+	}
+
+	var after *database.PunishmentCursor
+	if e.Cursor != "" {
+		c, err := decodePunishmentCursor(e.Cursor)
+		if err != nil {
+			return &pb.SearchPunishmentsResponse{}, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		after = c
+	}
+
+	page, err := s.db.SearchPunishments(filter, after, limit)
+	if err != nil {
+		return &pb.SearchPunishmentsResponse{}, err
+	}
+
+	res := &pb.SearchPunishmentsResponse{}
+	for _, p := range page.Punishments {
+		res.Entries = append(res.Entries, p.ToProtobuf())
+	}
+	if page.Next != nil {
+		res.NextCursor = encodePunishmentCursor(page.Next)
+	}
+	for level := database.WARN; level <= database.PERMBAN; level++ {
+		res.Totals = append(res.Totals, &pb.PunishLevelCount{
+			Level: level.ToProtobuf(),
+			Count: page.Totals[level],
+		})
+	}
+	return res, nil
+}
+
+// encodePunishmentCursor - Opaque cursor ("<date unix nanos>:<id>")
+func encodePunishmentCursor(c *database.PunishmentCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", c.Date.UnixNano(), c.ID)))
+}
+
+func decodePunishmentCursor(cursor string) (*database.PunishmentCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var nanos int64
+	var id uint
+	if _, err := fmt.Sscanf(string(b), "%d:%d", &nanos, &id); err != nil {
+		return nil, err
+	}
+	return &database.PunishmentCursor{Date: time.Unix(0, nanos), ID: id}, nil
 }
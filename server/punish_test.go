@@ -0,0 +1,91 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/synchthia/systera-api/database"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// searchLimitStorage - Records limit passed to SearchPunishments
+type searchLimitStorage struct {
+	database.Storage
+	limit int
+}
+
+func (s *searchLimitStorage) SearchPunishments(filter database.PunishmentFilter, after *database.PunishmentCursor, limit int) (database.PunishmentPage, error) {
+	s.limit = limit
+	return s.Storage.SearchPunishments(filter, after, limit)
+}
+
+func TestSearchPunishmentsLimit(t *testing.T) {
+	db := &searchLimitStorage{Storage: database.NewMemory()}
+	s := newTestServer(db)
+
+	for limit, want := range map[int32]int{0: 50, -1: 50, 20: 20, 500: 500, 100000: 500} {
+		if _, err := s.SearchPunishments(context.Background(), &pb.SearchPunishmentsRequest{Limit: limit}); err != nil {
+			t.Fatal(err)
+		}
+		if db.limit != want {
+			t.Errorf("SearchPunishments(limit=%d) queried %d, want %d", limit, db.limit, want)
+		}
+	}
+}
+
+func TestPunishmentCursor(t *testing.T) {
+	c := &database.PunishmentCursor{Date: time.Unix(0, 1700000000123456789), ID: 42}
+	got, err := decodePunishmentCursor(encodePunishmentCursor(c))
+	if err != nil || !got.Date.Equal(c.Date) || got.ID != c.ID {
+		t.Fatalf("cursor round trip: %+v %v", got, err)
+	}
+
+	for _, cursor := range []string{"!", "bm90LWEtY3Vyc29y"} {
+		if _, err := decodePunishmentCursor(cursor); err == nil {
+			t.Errorf("decodePunishmentCursor(%q): expected error", cursor)
+		}
+	}
+}
+
+func TestSearchPunishments(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		ctx := context.Background()
+		login(t, s, steve)
+		punish(t, s, steve, pb.PunishLevel_WARN, "spam", time.Time{})
+		punish(t, s, steve, pb.PunishLevel_KICK, "fly", time.Time{})
+		punish(t, s, steve, pb.PunishLevel_TEMPBAN, "grief", time.Now().Add(time.Hour))
+
+		_, err := s.SearchPunishments(ctx, &pb.SearchPunishmentsRequest{Cursor: "!"})
+		wantCode(t, err, codes.InvalidArgument)
+
+		var reasons []string
+		req := &pb.SearchPunishmentsRequest{Limit: 2}
+		for {
+			res, err := s.SearchPunishments(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range res.Entries {
+				reasons = append(reasons, e.Reason)
+			}
+			want := map[pb.PunishLevel]int64{pb.PunishLevel_WARN: 1, pb.PunishLevel_KICK: 1, pb.PunishLevel_TEMPBAN: 1, pb.PunishLevel_PERMBAN: 0}
+			if len(res.Totals) != len(want) {
+				t.Fatalf("totals: %+v", res.Totals)
+			}
+			for _, total := range res.Totals {
+				if total.Count != want[total.Level] {
+					t.Fatalf("total of %s: %d, want %d", total.Level, total.Count, want[total.Level])
+				}
+			}
+			if res.NextCursor == "" {
+				break
+			}
+			req.Cursor = res.NextCursor
+		}
+		if len(reasons) != 3 {
+			t.Fatalf("paged reasons: %q", reasons)
+		}
+	})
+}
@@ -2,7 +2,6 @@ package server
 
 import (
 	"github.com/synchthia/systera-api/database"
-	"github.com/synchthia/systera-api/stream"
 	pb "github.com/synchthia/systera-api/systerapb"
 	"golang.org/x/net/context"
 )
@@ -17,24 +16,29 @@ func (s *grpcServer) Report(ctx context.Context, e *pb.ReportRequest) (*pb.Repor
 		Name: e.To.Name,
 	}
 
-	entry, err := s.mysql.SetReport(from, to, e.ServerName, e.Message)
-	if err == nil {
-		stream.PublishReport(
-			&pb.ReportEntry{
-				From: &pb.PlayerIdentity{
-					Uuid: entry.ReporterPlayerUUID,
-					Name: entry.ReporterPlayerName,
-				},
-				To: &pb.PlayerIdentity{
-					Uuid: entry.TargetPlayerUUID,
-					Name: entry.TargetPlayerName,
-				},
-				Message: entry.Message,
-				Date:    entry.Date.UnixMilli(),
-				Server:  entry.Server,
-			},
-		)
+	entry, err := s.db.SetReport(from, to, e.ServerName, e.Message)
+	if err != nil {
+		return &pb.ReportResponse{}, err
 	}
 
-	return &pb.ReportResponse{}, err
+	report := entry.ToProtobuf()
+	s.stream.PublishReport(report)
+	return &pb.ReportResponse{Entry: report}, nil
+}
+
+func (s *grpcServer) GetReports(ctx context.Context, e *pb.GetReportsRequest) (*pb.GetReportsResponse, error) {
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	reports, err := s.db.GetReports(e.Uuid, limit)
+
+	var entries []*pb.ReportEntry
+	for _, r := range reports {
+		entries = append(entries, r.ToProtobuf())
+	}
+	return &pb.GetReportsResponse{Entry: entries}, err
 }
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/synchthia/systera-api/database"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+)
+
+// reportLimitStorage - Records limit passed to GetReports
+type reportLimitStorage struct {
+	database.Storage
+	limit int
+}
+
+func (s *reportLimitStorage) GetReports(targetUUID string, limit int) ([]database.Report, error) {
+	s.limit = limit
+	return s.Storage.GetReports(targetUUID, limit)
+}
+
+func TestGetReportsLimit(t *testing.T) {
+	db := &reportLimitStorage{Storage: database.NewMemory()}
+	s := newTestServer(db)
+
+	for limit, want := range map[int32]int{0: 50, -1: 50, 20: 20, 500: 500, 100000: 500} {
+		if _, err := s.GetReports(context.Background(), &pb.GetReportsRequest{Limit: limit}); err != nil {
+			t.Fatal(err)
+		}
+		if db.limit != want {
+			t.Errorf("GetReports(limit=%d) queried %d, want %d", limit, db.limit, want)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/database"
+	"github.com/synchthia/systera-api/stream"
+	"golang.org/x/net/context"
+)
+
+// schedulerBatch - Max announcements run per tick
+const schedulerBatch = 50
+
+// cronSchedules - Parsed cron expressions
+var cronSchedules sync.Map
+
+// parseCron - Parse cron expression (standard 5 fields or descriptor such as "@every 10m")
+func parseCron(spec string) (cron.Schedule, error) {
+	if v, ok := cronSchedules.Load(spec); ok {
+		return v.(cron.Schedule), nil
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, err
+	}
+	cronSchedules.Store(spec, schedule)
+	return schedule, nil
+}
+
+// AnnounceScheduler - Publish scheduled announcements
+// Safe to run on every replica: due announcements are claimed with row locks.
+type AnnounceScheduler struct {
+	db      database.Storage
+	stream  *stream.Stream
+	targets *targetResolver
+}
+
+// NewAnnounceScheduler - Create AnnounceScheduler
+func NewAnnounceScheduler(db database.Storage, stream *stream.Stream) *AnnounceScheduler {
+	return &AnnounceScheduler{
+		db:      db,
+		stream:  stream,
+		targets: &targetResolver{db: db},
+	}
+}
+
+// Run - Run scheduler every interval until ctx is done
+func (a *AnnounceScheduler) Run(ctx context.Context, interval time.Duration) {
+	logrus.WithField("interval", interval).Infof("[Scheduler] Started announce scheduler")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			due, err := a.db.ClaimDueAnnouncements(time.Now(), schedulerBatch, nextRun)
+			if err != nil {
+				logrus.WithError(err).Errorf("[Scheduler] Failed to run announcements")
+				continue
+			}
+			for i := range due {
+				a.publish(&due[i])
+			}
+		}
+	}
+}
+
+// publish - Publish claimed announcement
+func (a *AnnounceScheduler) publish(e *database.ScheduledAnnouncements) {
+	targets, err := a.targets.resolve(e.Target)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Scheduler] Failed to resolve target: %s (%d)", e.Target, e.ID)
+	}
+
+	msg := e.Message()
+	for _, target := range targets {
+		if err := a.stream.PublishAnnounce(target, msg); err != nil {
+			logrus.WithError(err).Errorf("[Scheduler] Failed to announce: %s (%d)", target, e.ID)
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"id":      e.ID,
+		"targets": targets,
+	}).Debugf("[Scheduler] Announced")
+}
+
+// nextRun - Next run of announcement (nil: finished)
+func nextRun(e *database.ScheduledAnnouncements) *time.Time {
+	if e.Cron == "" {
+		return nil
+	}
+	schedule, err := parseCron(e.Cron)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Scheduler] Invalid cron: %s (%d)", e.Cron, e.ID)
+		return nil
+	}
+	next := schedule.Next(time.Now())
+	return &next
+}
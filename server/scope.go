@@ -0,0 +1,33 @@
+package server
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+// staffToken - Token granting staff scope (empty: no client has staff scope)
+var staffToken string
+
+// SetStaffToken - Require "authorization: Bearer <token>" for staff scope
+func SetStaffToken(token string) {
+	staffToken = token
+}
+
+// hasStaffScope - Client has staff scope (gateway forwards Authorization header)
+func hasStaffScope(ctx context.Context) bool {
+	if staffToken == "" {
+		return false
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		token := strings.TrimPrefix(v, "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(staffToken)) == 1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"time"
+
+	"github.com/synchthia/systera-api/database"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultServerTTL - Server expires after this duration without heartbeat
+const defaultServerTTL = 30 * time.Second
+
+func (s *grpcServer) ServerHeartbeat(ctx context.Context, e *pb.ServerHeartbeatRequest) (*pb.Empty, error) {
+	entry := e.GetEntry()
+	if entry.GetName() == "" || entry.GetName() == "global" || isPattern(entry.GetName()) {
+		return &pb.Empty{}, status.Error(codes.InvalidArgument, "invalid server name")
+	}
+
+	ttl := time.Duration(e.Ttl) * time.Millisecond
+	if ttl <= 0 {
+		ttl = defaultServerTTL
+	}
+
+	now := time.Now()
+	server := &database.Servers{
+		Name:          entry.Name,
+		Address:       entry.Address,
+		Players:       entry.Players,
+		MaxPlayers:    entry.MaxPlayers,
+		MOTD:          entry.Motd,
+		State:         entry.State,
+		LastHeartbeat: now,
+		ExpiresAt:     now.Add(ttl),
+	}
+
+	up, err := s.db.ServerHeartbeat(server)
+	if err != nil {
+		return &pb.Empty{}, err
+	}
+	if up {
+		s.stream.PublishServer(pb.ServerStream_UP, server.ToProtobuf())
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *grpcServer) UnregisterServer(ctx context.Context, e *pb.UnregisterServerRequest) (*pb.Empty, error) {
+	server, down, err := s.db.UnregisterServer(e.Name)
+	if err != nil {
+		return &pb.Empty{}, err
+	}
+	if down {
+		s.stream.PublishServer(pb.ServerStream_DOWN, server.ToProtobuf())
+		if _, err := s.db.ClearServerPresence(server.Name, time.Now()); err != nil {
+			return &pb.Empty{}, err
+		}
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *grpcServer) ListServers(ctx context.Context, e *pb.ListServersRequest) (*pb.ListServersResponse, error) {
+	servers, err := s.db.GetServers(e.IncludeOffline)
+
+	var entries []*pb.ServerEntry
+	for _, server := range servers {
+		entries = append(entries, server.ToProtobuf())
+	}
+	return &pb.ListServersResponse{Entries: entries}, err
+}
+
+func (s *grpcServer) GetServer(ctx context.Context, e *pb.GetServerRequest) (*pb.GetServerResponse, error) {
+	server, err := s.db.GetServer(e.Name)
+	if err != nil {
+		return &pb.GetServerResponse{}, err
+	}
+	return &pb.GetServerResponse{Entry: server.ToProtobuf()}, nil
+}
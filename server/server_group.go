@@ -0,0 +1,38 @@
+package server
+
+import (
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *grpcServer) FetchServerGroups(ctx context.Context, e *pb.FetchServerGroupsRequest) (*pb.FetchServerGroupsResponse, error) {
+	groups, err := s.db.GetAllServerGroups()
+
+	var entries []*pb.ServerGroupEntry
+	for _, g := range groups {
+		entries = append(entries, g.ToProtobuf())
+	}
+	return &pb.FetchServerGroupsResponse{Groups: entries}, err
+}
+
+func (s *grpcServer) SetServerGroup(ctx context.Context, e *pb.SetServerGroupRequest) (*pb.Empty, error) {
+	group := e.GetGroup()
+	if group.GetName() == "" || group.GetName() == "global" || isPattern(group.GetName()) {
+		return &pb.Empty{}, status.Error(codes.InvalidArgument, "invalid group name")
+	}
+	for _, server := range group.Servers {
+		if err := validateTarget(server); err != nil {
+			return &pb.Empty{}, status.Errorf(codes.InvalidArgument, "invalid server pattern: %s", server)
+		}
+	}
+
+	err := s.db.SetServerGroup(group.Name, group.Servers)
+	return &pb.Empty{}, err
+}
+
+func (s *grpcServer) RemoveServerGroup(ctx context.Context, e *pb.RemoveServerGroupRequest) (*pb.Empty, error) {
+	err := s.db.RemoveServerGroup(e.Name)
+	return &pb.Empty{}, err
+}
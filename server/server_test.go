@@ -0,0 +1,268 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/database"
+	"github.com/synchthia/systera-api/stream"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMain(m *testing.M) {
+	logrus.SetOutput(ioutil.Discard)
+	os.Exit(m.Run())
+}
+
+// forEachBackend - Run fn against server backed by every Storage implementation
+func forEachBackend(t *testing.T, fn func(t *testing.T, s *grpcServer)) {
+	backends := []struct {
+		name string
+		open func(t *testing.T) database.Storage
+	}{
+		{"memory", func(t *testing.T) database.Storage {
+			return database.NewMemory()
+		}},
+		{"sqlite", func(t *testing.T) database.Storage {
+			db := database.NewSQLiteClient(filepath.Join(t.TempDir(), "systera.db"))
+			db.SetUUIDResolver(database.OfflineResolver{})
+			if _, err := db.MigrateUp(0); err != nil {
+				t.Fatalf("MigrateUp: %s", err)
+			}
+			return db
+		}},
+	}
+
+	for _, b := range backends {
+		b := b
+		t.Run(b.name, func(t *testing.T) {
+			fn(t, newTestServer(b.open(t)))
+		})
+	}
+}
+
+// newTestServer - Server publishing to in-process publisher
+func newTestServer(db database.Storage) *grpcServer {
+	return NewServer(db, stream.New(stream.NewInProcessPublisher(), nil))
+}
+
+// wantCode - Fail unless err has grpc code
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != code {
+		t.Fatalf("code = %s, want %s (err: %v)", got, code, err)
+	}
+}
+
+var (
+	steve = &pb.PlayerIdentity{Uuid: "00000000-0000-0000-0000-000000000001", Name: "Steve"}
+	alex  = &pb.PlayerIdentity{Uuid: "00000000-0000-0000-0000-000000000002", Name: "Alex"}
+	admin = &pb.PlayerIdentity{Uuid: "00000000-0000-0000-0000-0000000000ff", Name: "Admin"}
+)
+
+// login - InitPlayerProfile
+func login(t *testing.T, s *grpcServer, p *pb.PlayerIdentity) *pb.InitPlayerProfileResponse {
+	t.Helper()
+
+	res, err := s.InitPlayerProfile(context.Background(), &pb.InitPlayerProfileRequest{
+		Uuid:      p.Uuid,
+		Name:      p.Name,
+		IpAddress: "192.0.2.1",
+		Hostname:  "example.net",
+	})
+	if err != nil {
+		t.Fatalf("InitPlayerProfile(%s): %s", p.Name, err)
+	}
+	return res
+}
+
+// punish - SetPlayerPunish (forced)
+func punish(t *testing.T, s *grpcServer, to *pb.PlayerIdentity, level pb.PunishLevel, reason string, expire time.Time) {
+	t.Helper()
+
+	var expireAt int64
+	if !expire.IsZero() {
+		expireAt = expire.UnixMilli()
+	}
+	res, err := s.SetPlayerPunish(context.Background(), &pb.SetPlayerPunishRequest{
+		Force: true,
+		Entry: &pb.PunishEntry{
+			Level:        level,
+			Reason:       reason,
+			Date:         time.Now().UnixMilli(),
+			Expire:       expireAt,
+			PunishedFrom: admin,
+			PunishedTo:   &pb.PlayerIdentity{Uuid: to.Uuid, Name: to.Name},
+		},
+	})
+	if err != nil || res.Duplicate || res.Cooldown {
+		t.Fatalf("SetPlayerPunish(%s, %s): %+v %v", to.Name, level, res, err)
+	}
+}
+
+func TestInitPlayerProfile(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		res := login(t, s, steve)
+		if !res.FirstJoin || res.NameChanged || res.KickMessage != "" {
+			t.Fatalf("first login: %+v", res)
+		}
+
+		punish(t, s, steve, pb.PunishLevel_PERMBAN, "x-ray", time.Time{})
+
+		res = login(t, s, &pb.PlayerIdentity{Uuid: steve.Uuid, Name: "Steve_"})
+		if res.FirstJoin || !res.NameChanged || res.PreviousName != "Steve" {
+			t.Fatalf("renamed login: %+v", res)
+		}
+		if res.Ban != pb.PunishLevel_PERMBAN || res.ActiveBan.GetReason() != "x-ray" || res.KickMessage == "" {
+			t.Fatalf("banned login: ban=%s active=%v kick=%q", res.Ban, res.ActiveBan, res.KickMessage)
+		}
+
+		profile, err := s.FetchPlayerProfileByName(context.Background(), &pb.FetchPlayerProfileByNameRequest{Name: "steve_"})
+		if err != nil || profile.Entry.Uuid != steve.Uuid || profile.StaffNoteCount != 0 {
+			t.Fatalf("FetchPlayerProfileByName: %+v %v", profile, err)
+		}
+	})
+}
+
+func TestPunishAndUnBan(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		ctx := context.Background()
+		login(t, s, steve)
+		punish(t, s, steve, pb.PunishLevel_WARN, "spam", time.Time{})
+		punish(t, s, steve, pb.PunishLevel_TEMPBAN, "grief", time.Now().Add(time.Hour))
+
+		res, err := s.GetPlayerPunish(ctx, &pb.GetPlayerPunishRequest{Uuid: steve.Uuid, FilterLevel: pb.PunishLevel_WARN})
+		if err != nil || len(res.Entry) != 2 || res.Entry[1].Id == 0 {
+			t.Fatalf("GetPlayerPunish: %+v %v", res, err)
+		}
+
+		if _, err := s.UnBan(ctx, &pb.UnBanRequest{Target: &pb.PlayerIdentity{Name: "Steve"}}); err != nil {
+			t.Fatal(err)
+		}
+		res, _ = s.GetPlayerPunish(ctx, &pb.GetPlayerPunishRequest{Uuid: steve.Uuid, FilterLevel: pb.PunishLevel_TEMPBAN})
+		if len(res.Entry) != 0 {
+			t.Fatalf("bans after UnBan: %+v", res.Entry)
+		}
+	})
+}
+
+func TestReport(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		ctx := context.Background()
+		res, err := s.Report(ctx, &pb.ReportRequest{From: steve, To: alex, ServerName: "lobby", Message: "fly"})
+		if err != nil || res.Entry.GetId() == 0 {
+			t.Fatalf("Report: %+v %v", res, err)
+		}
+
+		reports, err := s.GetReports(ctx, &pb.GetReportsRequest{Uuid: alex.Uuid})
+		if err != nil || len(reports.Entry) != 1 || reports.Entry[0].Message != "fly" {
+			t.Fatalf("GetReports: %+v %v", reports, err)
+		}
+	})
+}
+
+func TestGroups(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		ctx := context.Background()
+		if _, err := s.CreateGroup(ctx, &pb.CreateGroupRequest{GroupEntry: &pb.GroupEntry{GroupName: "admin", GroupPrefix: "&c"}}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.AddPermission(ctx, &pb.AddPermissionRequest{GroupName: "admin", Target: "global", Permissions: []string{"systera.punish"}}); err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := s.FetchGroups(ctx, &pb.FetchGroupsRequest{})
+		if err != nil || len(res.Groups) != 1 || res.Groups[0].GroupName != "admin" {
+			t.Fatalf("FetchGroups: %+v %v", res, err)
+		}
+	})
+}
+
+func TestAnnounceTargets(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		ctx := context.Background()
+		if _, err := s.ServerHeartbeat(ctx, &pb.ServerHeartbeatRequest{Entry: &pb.ServerEntry{Name: "skywars-1"}}); err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := s.Announce(ctx, &pb.AnnounceRequest{Target: "skywars-*", Message: "hi"})
+		if err != nil || len(res.Targets) != 1 || res.Targets[0] != "skywars-1" {
+			t.Fatalf("Announce(pattern): %+v %v", res, err)
+		}
+
+		_, err = s.Announce(ctx, &pb.AnnounceRequest{Target: "bedwars-*", Message: "hi"})
+		wantCode(t, err, codes.NotFound)
+
+		_, err = s.Announce(ctx, &pb.AnnounceRequest{Target: "[", Message: "hi"})
+		wantCode(t, err, codes.InvalidArgument)
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		ctx := context.Background()
+
+		_, err := s.Dispatch(ctx, &pb.DispatchRequest{Target: "lobby", Cmd: "say hi", WaitTimeout: -1})
+		wantCode(t, err, codes.InvalidArgument)
+		_, err = s.Dispatch(ctx, &pb.DispatchRequest{Target: "lobby", Cmd: "say hi", WaitTimeout: maxDispatchWait.Milliseconds() + 1})
+		wantCode(t, err, codes.InvalidArgument)
+
+		// Pattern matching nothing is not recorded
+		_, err = s.Dispatch(ctx, &pb.DispatchRequest{Target: "skywars-*", Cmd: "say hi"})
+		wantCode(t, err, codes.NotFound)
+
+		res, err := s.Dispatch(ctx, &pb.DispatchRequest{Target: "lobby", Cmd: "say hi", From: admin})
+		if err != nil || res.Id == "" || len(res.Targets) != 1 {
+			t.Fatalf("Dispatch: %+v %v", res, err)
+		}
+		if _, err := s.ReportDispatchResult(ctx, &pb.ReportDispatchResultRequest{Id: res.Id, ServerName: "lobby", Success: true, Output: "ok"}); err != nil {
+			t.Fatal(err)
+		}
+
+		history, err := s.GetDispatchHistory(ctx, &pb.GetDispatchHistoryRequest{})
+		if err != nil || len(history.Entries) != 1 || len(history.Entries[0].Results) != 1 {
+			t.Fatalf("GetDispatchHistory: %+v %v", history, err)
+		}
+	})
+}
+
+func TestDispatchWait(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		ctx := context.Background()
+
+		// Result reported while waiting
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 50; i++ {
+				history, _ := s.GetDispatchHistory(ctx, &pb.GetDispatchHistoryRequest{})
+				if history != nil && len(history.Entries) != 0 {
+					s.ReportDispatchResult(ctx, &pb.ReportDispatchResultRequest{Id: history.Entries[0].Id, ServerName: "lobby", Success: true})
+					return
+				}
+				time.Sleep(20 * time.Millisecond)
+			}
+		}()
+
+		res, err := s.Dispatch(ctx, &pb.DispatchRequest{Target: "lobby", Cmd: "say hi", WaitTimeout: 5000})
+		<-done
+		if err != nil || len(res.Results) != 1 || !res.Results[0].Success {
+			t.Fatalf("Dispatch(wait): %+v %v", res, err)
+		}
+	})
+}
+
+func TestDeleteScheduledAnnouncement(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s *grpcServer) {
+		_, err := s.DeleteScheduledAnnouncement(context.Background(), &pb.DeleteScheduledAnnouncementRequest{Id: 42})
+		wantCode(t, err, codes.NotFound)
+	})
+}
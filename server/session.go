@@ -0,0 +1,74 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+)
+
+func (s *grpcServer) GetPlaytime(ctx context.Context, e *pb.GetPlaytimeRequest) (*pb.GetPlaytimeResponse, error) {
+	playtime, err := s.db.GetPlaytime(e.Uuid)
+	if err != nil {
+		return &pb.GetPlaytimeResponse{}, err
+	}
+
+	res := &pb.GetPlaytimeResponse{}
+	for server, t := range playtime {
+		res.Total += t
+		res.Servers = append(res.Servers, &pb.ServerPlaytime{
+			ServerName: server,
+			Playtime:   t,
+		})
+	}
+	sort.Slice(res.Servers, func(i, j int) bool {
+		return res.Servers[i].Playtime > res.Servers[j].Playtime
+	})
+	return res, nil
+}
+
+func (s *grpcServer) GetSessionHistory(ctx context.Context, e *pb.GetSessionHistoryRequest) (*pb.GetSessionHistoryResponse, error) {
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	sessions, err := s.db.GetSessions(e.Uuid, e.ServerName, limit)
+
+	var entries []*pb.SessionEntry
+	for _, session := range sessions {
+		entries = append(entries, session.ToProtobuf())
+	}
+	return &pb.GetSessionHistoryResponse{Sessions: entries}, err
+}
+
+func (s *grpcServer) GetPlaytimeLeaderboard(ctx context.Context, e *pb.GetPlaytimeLeaderboardRequest) (*pb.GetPlaytimeLeaderboardResponse, error) {
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 10
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	var since time.Time
+	if e.Since > 0 {
+		since = time.UnixMilli(e.Since)
+	}
+
+	leaderboard, err := s.db.GetPlaytimeLeaderboard(e.ServerName, since, limit)
+
+	var entries []*pb.PlaytimeEntry
+	for _, l := range leaderboard {
+		entries = append(entries, &pb.PlaytimeEntry{
+			Identity: &pb.PlayerIdentity{
+				Uuid: l.PlayerUUID,
+				Name: l.Name,
+			},
+			Playtime: l.Playtime,
+		})
+	}
+	return &pb.GetPlaytimeLeaderboardResponse{Entries: entries}, err
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/synchthia/systera-api/database"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+)
+
+// sessionLimitStorage - Records limits passed to session queries
+type sessionLimitStorage struct {
+	database.Storage
+	limit int
+}
+
+func (s *sessionLimitStorage) GetSessions(uuid, server string, limit int) ([]database.PlayerSessions, error) {
+	s.limit = limit
+	return s.Storage.GetSessions(uuid, server, limit)
+}
+
+func (s *sessionLimitStorage) GetPlaytimeLeaderboard(server string, since time.Time, limit int) ([]database.PlaytimeEntry, error) {
+	s.limit = limit
+	return s.Storage.GetPlaytimeLeaderboard(server, since, limit)
+}
+
+func TestSessionLimits(t *testing.T) {
+	db := &sessionLimitStorage{Storage: database.NewMemory()}
+	s := newTestServer(db)
+	ctx := context.Background()
+
+	for limit, want := range map[int32]int{0: 50, -1: 50, 20: 20, 100000: 500} {
+		s.GetSessionHistory(ctx, &pb.GetSessionHistoryRequest{Uuid: steve.Uuid, Limit: limit})
+		if db.limit != want {
+			t.Errorf("GetSessionHistory(limit=%d) queried %d, want %d", limit, db.limit, want)
+		}
+	}
+	for limit, want := range map[int32]int{0: 10, 20: 20, 100000: 500} {
+		s.GetPlaytimeLeaderboard(ctx, &pb.GetPlaytimeLeaderboardRequest{Limit: limit})
+		if db.limit != want {
+			t.Errorf("GetPlaytimeLeaderboard(limit=%d) queried %d, want %d", limit, db.limit, want)
+		}
+	}
+}
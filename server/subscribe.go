@@ -0,0 +1,26 @@
+package server
+
+import (
+	pb "github.com/synchthia/systera-api/systerapb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *grpcServer) Subscribe(e *pb.SubscribeRequest, ss pb.Systera_SubscribeServer) error {
+	sub := s.stream.Subscribe(e.ServerName, e.Topics)
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ss.Context().Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, sub.Err().Error())
+			}
+			if err := ss.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
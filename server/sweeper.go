@@ -0,0 +1,70 @@
+package server
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/database"
+	"github.com/synchthia/systera-api/stream"
+	pb "github.com/synchthia/systera-api/systerapb"
+	"golang.org/x/net/context"
+)
+
+// Sweeper - Expire servers and player presence without heartbeat
+// Safe to run on every replica: each expiry is applied (and published) once.
+type Sweeper struct {
+	db     database.Storage
+	stream *stream.Stream
+}
+
+// NewSweeper - Create Sweeper
+func NewSweeper(db database.Storage, stream *stream.Stream) *Sweeper {
+	return &Sweeper{
+		db:     db,
+		stream: stream,
+	}
+}
+
+// Run - Sweep every interval until ctx is done
+func (s *Sweeper) Run(ctx context.Context, interval time.Duration) {
+	logrus.WithField("interval", interval).Infof("[Sweeper] Started sweeper")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sweep(time.Now())
+		}
+	}
+}
+
+func (s *Sweeper) sweep(now time.Time) {
+	expired, err := s.db.ExpireServers(now)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Sweeper] Failed to expire servers")
+	}
+	for _, server := range expired {
+		logrus.WithField("server", server.Name).Infof("[Sweeper] Server expired")
+		s.stream.PublishServer(pb.ServerStream_DOWN, server.ToProtobuf())
+
+		// Players of down server are gone even if their lease is still valid
+		players, err := s.db.ClearServerPresence(server.Name, server.ExpiresAt)
+		if err != nil {
+			logrus.WithError(err).Errorf("[Sweeper] Failed to clear presence (%s)", server.Name)
+		} else if len(players) != 0 {
+			logrus.WithFields(logrus.Fields{"server": server.Name, "players": len(players)}).Infof("[Sweeper] Presence cleared")
+		}
+	}
+
+	players, err := s.db.ExpirePresence(now)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Sweeper] Failed to expire presence")
+	}
+	if len(players) != 0 {
+		logrus.WithField("players", len(players)).Infof("[Sweeper] Presence expired")
+	}
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"errors"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/synchthia/systera-api/database"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// targetResolver - Resolve Announce / Dispatch target to server names
+type targetResolver struct {
+	db database.Storage
+}
+
+// isPattern - Target is a glob pattern (ex. "minigame-*")
+func isPattern(target string) bool {
+	return strings.ContainsAny(target, "*?[")
+}
+
+// validateTarget - Check syntax of target pattern
+func validateTarget(target string) error {
+	_, err := path.Match(target, "")
+	return err
+}
+
+// resolve - Resolve target to server names
+//
+//	"global"       -> "global" (channel subscribed by every server)
+//	server group   -> members (patterns in members are expanded)
+//	glob pattern   -> matching known servers
+//	otherwise      -> target as server name
+//
+// Invalid patterns are InvalidArgument, groups / patterns matching no server are NotFound.
+func (r *targetResolver) resolve(target string) ([]string, error) {
+	if target == "global" {
+		return []string{target}, nil
+	}
+	if err := validateTarget(target); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target: %s", err)
+	}
+
+	group, err := r.db.GetServerGroup(target)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	var patterns []string
+	if err == nil {
+		for _, m := range group.Members {
+			patterns = append(patterns, m.ServerName)
+		}
+	} else {
+		patterns = []string{target}
+	}
+
+	var known []string
+	targets := make(map[string]bool)
+	for _, p := range patterns {
+		if !isPattern(p) {
+			targets[p] = true
+			continue
+		}
+
+		if known == nil {
+			if known, err = r.knownServers(); err != nil {
+				return nil, err
+			}
+		}
+		for _, name := range known {
+			if ok, _ := path.Match(p, name); ok {
+				targets[name] = true
+			}
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no servers match target: %s", target)
+	}
+
+	var names []string
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// knownServers - Registered, online players' and grouped servers
+func (r *targetResolver) knownServers() ([]string, error) {
+	servers := make(map[string]bool)
+
+	registered, err := r.db.GetServers(false)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range registered {
+		servers[s.Name] = true
+	}
+
+	online, err := r.db.CountOnlinePlayers()
+	if err != nil {
+		return nil, err
+	}
+	for name := range online {
+		servers[name] = true
+	}
+
+	groups, err := r.db.GetAllServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		for _, m := range g.Members {
+			if !isPattern(m.ServerName) {
+				servers[m.ServerName] = true
+			}
+		}
+	}
+
+	names := []string{}
+	for name := range servers {
+		names = append(names, name)
+	}
+	return names, nil
+}
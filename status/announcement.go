@@ -0,0 +1,16 @@
+package status
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ErrAnnouncementNotFound - When scheduled announcement does not exists
+var ErrAnnouncementNotFound = &Error{
+	Error: errors.New("announcement not found"),
+	Code:  "ERR_ANNOUNCEMENT_NOT_FOUND",
+	GrpcError: &GrpcError{
+		Codes: codes.NotFound,
+	},
+}
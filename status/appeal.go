@@ -0,0 +1,61 @@
+package status
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ErrPunishmentNotFound - When punishment does not exists
+var ErrPunishmentNotFound = &Error{
+	Error: errors.New("punishment not found"),
+	Code:  "ERR_PUNISHMENT_NOT_FOUND",
+	GrpcError: &GrpcError{
+		Codes: codes.NotFound,
+	},
+}
+
+// ErrAppealNotFound - When appeal does not exists
+var ErrAppealNotFound = &Error{
+	Error: errors.New("appeal not found"),
+	Code:  "ERR_APPEAL_NOT_FOUND",
+	GrpcError: &GrpcError{
+		Codes: codes.NotFound,
+	},
+}
+
+// ErrAppealNotAllowed - When punishment is not active or not punished to appellant
+var ErrAppealNotAllowed = &Error{
+	Error: errors.New("punishment can not be appealed"),
+	Code:  "ERR_APPEAL_NOT_ALLOWED",
+	GrpcError: &GrpcError{
+		Codes: codes.FailedPrecondition,
+	},
+}
+
+// ErrAppealAlreadyOpen - When punishment already has open appeal
+var ErrAppealAlreadyOpen = &Error{
+	Error: errors.New("appeal already open"),
+	Code:  "ERR_APPEAL_ALREADY_OPEN",
+	GrpcError: &GrpcError{
+		Codes: codes.AlreadyExists,
+	},
+}
+
+// ErrAppealCooldown - When punishment was appealed recently
+var ErrAppealCooldown = &Error{
+	Error: errors.New("appeal is in cooldown"),
+	Code:  "ERR_APPEAL_COOLDOWN",
+	GrpcError: &GrpcError{
+		Codes: codes.ResourceExhausted,
+	},
+}
+
+// ErrAppealClosed - When appeal is already reviewed
+var ErrAppealClosed = &Error{
+	Error: errors.New("appeal already reviewed"),
+	Code:  "ERR_APPEAL_CLOSED",
+	GrpcError: &GrpcError{
+		Codes: codes.FailedPrecondition,
+	},
+}
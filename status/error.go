@@ -1,6 +1,8 @@
 package status
 
 import (
+	"errors"
+
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -18,13 +20,45 @@ type Error struct {
 	GrpcError *GrpcError `json:"grpc_error,omitempty"`
 }
 
+// knownErrors - Errors resolved by Convert
+var knownErrors = []*Error{
+	ErrPlayerNotFound,
+	ErrPlayerAlreadyExists,
+	ErrPunishmentNotFound,
+	ErrAppealNotFound,
+	ErrAppealNotAllowed,
+	ErrAppealAlreadyOpen,
+	ErrAppealCooldown,
+	ErrAppealClosed,
+	ErrReportNotFound,
+	ErrEvidenceNotFound,
+	ErrEvidenceBlobDisabled,
+	ErrNoteNotFound,
+	ErrAnnouncementNotFound,
+}
+
 func (e *Error) ToGrpcError() *status.Status {
-    s := status.New(e.GrpcError.Codes, e.Error.Error())
-    s.WithDetails(&errdetails.ErrorInfo{
-        Reason: e.Error.Error(),
-        Metadata: map[string]string{
-            "code": e.Code,
-        },
-    })
-    return s
+	s := status.New(e.GrpcError.Codes, e.Error.Error())
+	s.WithDetails(&errdetails.ErrorInfo{
+		Reason: e.Error.Error(),
+		Metadata: map[string]string{
+			"code": e.Code,
+		},
+	})
+	return s
+}
+
+// Convert - Convert error to grpc status (known errors keep their code)
+func Convert(err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+
+	for _, e := range knownErrors {
+		if errors.Is(err, e.Error) {
+			return e.ToGrpcError()
+		}
+	}
+
+	return status.New(codes.Unknown, err.Error())
 }
@@ -0,0 +1,25 @@
+package status
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ErrEvidenceNotFound - When evidence does not exists
+var ErrEvidenceNotFound = &Error{
+	Error: errors.New("evidence not found"),
+	Code:  "ERR_EVIDENCE_NOT_FOUND",
+	GrpcError: &GrpcError{
+		Codes: codes.NotFound,
+	},
+}
+
+// ErrEvidenceBlobDisabled - When blob is attached but object store is not configured
+var ErrEvidenceBlobDisabled = &Error{
+	Error: errors.New("evidence blob store is not configured"),
+	Code:  "ERR_EVIDENCE_BLOB_DISABLED",
+	GrpcError: &GrpcError{
+		Codes: codes.FailedPrecondition,
+	},
+}
@@ -0,0 +1,16 @@
+package status
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ErrNoteNotFound - When staff note does not exists
+var ErrNoteNotFound = &Error{
+	Error: errors.New("note not found"),
+	Code:  "ERR_NOTE_NOT_FOUND",
+	GrpcError: &GrpcError{
+		Codes: codes.NotFound,
+	},
+}
@@ -0,0 +1,16 @@
+package status
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ErrReportNotFound - When report does not exists
+var ErrReportNotFound = &Error{
+	Error: errors.New("report not found"),
+	Code:  "ERR_REPORT_NOT_FOUND",
+	GrpcError: &GrpcError{
+		Codes: codes.NotFound,
+	},
+}
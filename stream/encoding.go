@@ -0,0 +1,146 @@
+package stream
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Encoding - Payload encoding of published messages
+type Encoding string
+
+const (
+	// EncodingJSON - Legacy encoding/json payload on "<channel>"
+	EncodingJSON Encoding = "json"
+
+	// EncodingProtoJSON - protojson payload on "protojson:<channel>"
+	EncodingProtoJSON Encoding = "protojson"
+
+	// EncodingProto - Binary protobuf with envelope header on "proto:<channel>"
+	EncodingProto Encoding = "proto"
+)
+
+// SchemaVersion - Version of stream messages in envelope header
+// (bump on incompatible changes to *Stream messages)
+const SchemaVersion uint8 = 1
+
+// envelopeMagic - Envelope header prefix
+// Header: magic(2) | envelope version(1) | schema version(1) | type name length(1) | type name | protobuf
+var envelopeMagic = []byte{'S', 'Y'}
+
+const envelopeVersion uint8 = 1
+
+var protojsonMarshal = protojson.MarshalOptions{UseProtoNames: true}
+
+var protojsonUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// ParseEncodings - Parse comma separated encodings (ex. "json,proto")
+func ParseEncodings(s string) ([]Encoding, error) {
+	var encodings []Encoding
+	for _, v := range strings.Split(s, ",") {
+		switch e := Encoding(strings.TrimSpace(v)); e {
+		case "":
+			continue
+		case EncodingJSON, EncodingProtoJSON, EncodingProto:
+			encodings = append(encodings, e)
+		default:
+			return nil, fmt.Errorf("unknown encoding: %s", v)
+		}
+	}
+	return encodings, nil
+}
+
+// Channel - Channel name for encoding (legacy JSON keeps original channel)
+func (e Encoding) Channel(channel string) string {
+	if e == EncodingJSON {
+		return channel
+	}
+	return string(e) + ":" + channel
+}
+
+// Marshal - Encode message
+func (e Encoding) Marshal(d proto.Message) ([]byte, error) {
+	switch e {
+	case EncodingProtoJSON:
+		return protojsonMarshal.Marshal(d)
+	case EncodingProto:
+		return MarshalEnvelope(d)
+	default:
+		return json.Marshal(d)
+	}
+}
+
+// MarshalEnvelope - Binary protobuf with envelope header
+func MarshalEnvelope(d proto.Message) ([]byte, error) {
+	name := string(d.ProtoReflect().Descriptor().FullName())
+	if len(name) > 255 {
+		return nil, fmt.Errorf("type name too long: %s", name)
+	}
+
+	body, err := proto.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, 0, len(envelopeMagic)+3+len(name)+len(body))
+	b = append(b, envelopeMagic...)
+	b = append(b, envelopeVersion, SchemaVersion, uint8(len(name)))
+	b = append(b, name...)
+	return append(b, body...), nil
+}
+
+// UnmarshalEnvelope - Decode envelope (returns message and schema version)
+func UnmarshalEnvelope(b []byte) (proto.Message, uint8, error) {
+	if !isEnvelope(b) || len(b) < len(envelopeMagic)+3 {
+		return nil, 0, errors.New("not an envelope")
+	}
+	b = b[len(envelopeMagic):]
+
+	if b[0] != envelopeVersion {
+		return nil, 0, fmt.Errorf("unsupported envelope version: %d", b[0])
+	}
+	schema, n := b[1], int(b[2])
+	b = b[3:]
+	if len(b) < n {
+		return nil, 0, errors.New("truncated envelope")
+	}
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(b[:n]))
+	if err != nil {
+		return nil, 0, err
+	}
+	d := mt.New().Interface()
+	if err := proto.Unmarshal(b[n:], d); err != nil {
+		return nil, 0, err
+	}
+	return d, schema, nil
+}
+
+func isEnvelope(b []byte) bool {
+	return bytes.HasPrefix(b, envelopeMagic)
+}
+
+// unmarshalPayload - Decode payload of any encoding into d
+func unmarshalPayload(payload []byte, d proto.Message) error {
+	if isEnvelope(payload) {
+		m, _, err := UnmarshalEnvelope(payload)
+		if err != nil {
+			return err
+		}
+		if m.ProtoReflect().Descriptor() != d.ProtoReflect().Descriptor() {
+			return fmt.Errorf("unexpected message type: %s", m.ProtoReflect().Descriptor().FullName())
+		}
+		proto.Merge(d, m)
+		return nil
+	}
+
+	// protojson accepts both legacy (encoding/json) and protojson payloads
+	return protojsonUnmarshal.Unmarshal(payload, d)
+}
@@ -0,0 +1,110 @@
+package stream
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/synchthia/systera-api/systerapb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseEncodings(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want []Encoding
+		err  bool
+	}{
+		{"", nil, false},
+		{"json", []Encoding{EncodingJSON}, false},
+		{"json, proto", []Encoding{EncodingJSON, EncodingProto}, false},
+		{"protojson,,proto,", []Encoding{EncodingProtoJSON, EncodingProto}, false},
+		{"json,xml", nil, true},
+		{"JSON", nil, true},
+	} {
+		got, err := ParseEncodings(tc.in)
+		if (err != nil) != tc.err {
+			t.Errorf("ParseEncodings(%q): err = %v", tc.in, err)
+			continue
+		}
+		if len(got) != len(tc.want) {
+			t.Errorf("ParseEncodings(%q) = %v, want %v", tc.in, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("ParseEncodings(%q) = %v, want %v", tc.in, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestEncodingChannel(t *testing.T) {
+	for e, want := range map[Encoding]string{
+		EncodingJSON:      "systera.system.global",
+		EncodingProtoJSON: "protojson:systera.system.global",
+		EncodingProto:     "proto:systera.system.global",
+	} {
+		if got := e.Channel("systera.system.global"); got != want {
+			t.Errorf("%s.Channel = %q, want %q", e, got, want)
+		}
+	}
+}
+
+func TestEnvelope(t *testing.T) {
+	msg := &systerapb.SystemStream{Type: systerapb.SystemStream_ANNOUNCE, Msg: "hi", Id: "1"}
+	b, err := MarshalEnvelope(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b[:2]) != "SY" || b[2] != envelopeVersion || b[3] != SchemaVersion || string(b[5:5+b[4]]) != "systerapb.SystemStream" {
+		t.Fatalf("envelope header: %q", b[:5+b[4]])
+	}
+
+	got, schema, err := UnmarshalEnvelope(b)
+	if err != nil || schema != SchemaVersion || !proto.Equal(got, msg) {
+		t.Fatalf("UnmarshalEnvelope: %v %d %v", got, schema, err)
+	}
+
+	for name, bad := range map[string][]byte{
+		"not envelope":     []byte(`{"msg":"hi"}`),
+		"short":            []byte("SY"),
+		"envelope version": append([]byte{'S', 'Y', 9}, b[3:]...),
+		"truncated":        b[:8],
+		"unknown type":     append([]byte{'S', 'Y', envelopeVersion, SchemaVersion, 3}, "a.B"...),
+	} {
+		if _, _, err := UnmarshalEnvelope(bad); err == nil {
+			t.Errorf("UnmarshalEnvelope(%s): expected error", name)
+		}
+	}
+}
+
+func TestDecodeEvent(t *testing.T) {
+	msg := &systerapb.SystemStream{Type: systerapb.SystemStream_ANNOUNCE, Msg: "hi", Id: "1"}
+
+	// Legacy encoding/json payload stores enums as numbers
+	legacy, _ := json.Marshal(msg)
+	for _, e := range []Encoding{EncodingJSON, EncodingProtoJSON, EncodingProto} {
+		payload, err := e.Marshal(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e == EncodingJSON && string(payload) != string(legacy) {
+			t.Fatalf("json payload = %s, want %s", payload, legacy)
+		}
+
+		event, err := DecodeEvent("systera.system.global", payload)
+		if err != nil || event.Channel != "systera.system.global" || !proto.Equal(event.GetSystem(), msg) {
+			t.Errorf("DecodeEvent(%s): %v %v", e, event, err)
+		}
+	}
+
+	if _, err := DecodeEvent("systera.unknown.global", []byte("{}")); err == nil {
+		t.Error("DecodeEvent(unknown topic): expected error")
+	}
+
+	// Envelope of another message type on system channel
+	other, _ := MarshalEnvelope(&systerapb.ChatStream{})
+	if _, err := DecodeEvent("systera.system.global", other); err == nil {
+		t.Error("DecodeEvent(mismatched envelope): expected error")
+	}
+}
@@ -1,43 +1,29 @@
 package stream
 
 import (
-	"encoding/json"
-
 	"github.com/sirupsen/logrus"
 	"github.com/synchthia/systera-api/systerapb"
 )
 
 // PublishGroup - Publish Group
-func PublishGroup(data *systerapb.GroupEntry) {
-	c := pool.Get()
-	defer c.Close()
-
+func (s *Stream) PublishGroup(data *systerapb.GroupEntry) {
 	d := &systerapb.GroupStream{
 		Type:       systerapb.GroupStream_GROUP,
 		GroupEntry: data,
 	}
-	serialized, _ := json.Marshal(&d)
-	logrus.Debugln(d)
-
-	_, err := c.Do("PUBLISH", "systera.group.global", string(serialized))
+	err := s.publish("systera.group.global", d)
 	if err != nil {
 		logrus.WithError(err).Errorf("[Publish] Failed Publish group")
 	}
 }
 
 // PublishPerms - Publish Permissions
-func PublishPerms(target string, data *systerapb.GroupEntry) {
-	c := pool.Get()
-	defer c.Close()
-
+func (s *Stream) PublishPerms(target string, data *systerapb.GroupEntry) {
 	d := &systerapb.GroupStream{
 		Type:       systerapb.GroupStream_PERMISSIONS,
 		GroupEntry: data,
 	}
-	serialized, _ := json.Marshal(&d)
-	logrus.Debugln(d)
-
-	_, err := c.Do("PUBLISH", "systera.group."+target, string(serialized))
+	err := s.publish("systera.group."+target, d)
 	if err != nil {
 		logrus.WithError(err).Errorf("[Publish] Failed Publish permissions")
 	}
@@ -0,0 +1,150 @@
+package stream
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/systerapb"
+	"google.golang.org/protobuf/proto"
+)
+
+// subscriberBuffer - Pending events per subscriber before it is considered too slow
+const subscriberBuffer = 256
+
+// ErrSubscriberTooSlow - Subscription closed because the buffer was full
+var ErrSubscriberTooSlow = errors.New("subscriber too slow")
+
+// Subscription - In-process subscription for systera.* events
+type Subscription struct {
+	hub        *hub
+	serverName string
+	topics     map[string]bool
+	events     chan *systerapb.StreamEvent
+
+	once sync.Once
+	err  error
+}
+
+// hub - Fan-out to in-process subscriptions
+type hub struct {
+	mu   sync.RWMutex
+	subs map[*Subscription]struct{}
+}
+
+func newHub() *hub {
+	return &hub{
+		subs: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe - Subscribe events targeted to "global" or serverName (all topics if empty)
+func (s *Stream) Subscribe(serverName string, topics []systerapb.StreamTopic) *Subscription {
+	sub := &Subscription{
+		hub:        s.hub,
+		serverName: serverName,
+		topics:     make(map[string]bool),
+		events:     make(chan *systerapb.StreamEvent, subscriberBuffer),
+	}
+	for _, t := range topics {
+		sub.topics[strings.ToLower(t.String())] = true
+	}
+
+	s.hub.mu.Lock()
+	s.hub.subs[sub] = struct{}{}
+	s.hub.mu.Unlock()
+
+	logrus.WithFields(logrus.Fields{
+		"server": serverName,
+		"topics": topics,
+	}).Infof("[Subscribe] Subscribed")
+
+	return sub
+}
+
+// Events - Event channel (closed when subscription ends)
+func (s *Subscription) Events() <-chan *systerapb.StreamEvent {
+	return s.events
+}
+
+// Err - Reason of closed subscription (nil if closed by Close)
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Close - Unsubscribe
+func (s *Subscription) Close() {
+	s.close(nil)
+}
+
+func (s *Subscription) close(err error) {
+	s.once.Do(func() {
+		s.hub.mu.Lock()
+		delete(s.hub.subs, s)
+		s.hub.mu.Unlock()
+
+		s.err = err
+		close(s.events)
+	})
+}
+
+func (s *Subscription) match(topic, target string) bool {
+	if len(s.topics) != 0 && !s.topics[topic] {
+		return false
+	}
+	return target == "global" || target == s.serverName
+}
+
+// dispatch - Deliver published message to in-process subscribers
+func (h *hub) dispatch(channel string, d proto.Message) {
+	// systera.<topic>.<target>
+	parts := strings.SplitN(channel, ".", 3)
+	if len(parts) != 3 {
+		return
+	}
+	topic, target := parts[1], parts[2]
+
+	h.mu.RLock()
+	var slow []*Subscription
+	var event *systerapb.StreamEvent
+	for sub := range h.subs {
+		if !sub.match(topic, target) {
+			continue
+		}
+		if event == nil {
+			event = toEvent(channel, d)
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, sub := range slow {
+		logrus.WithField("server", sub.serverName).Warnf("[Subscribe] Subscriber too slow, closing")
+		sub.close(ErrSubscriberTooSlow)
+	}
+}
+
+func toEvent(channel string, d proto.Message) *systerapb.StreamEvent {
+	event := &systerapb.StreamEvent{Channel: channel}
+	switch m := d.(type) {
+	case *systerapb.SystemStream:
+		event.Event = &systerapb.StreamEvent_System{System: m}
+	case *systerapb.PlayerStream:
+		event.Event = &systerapb.StreamEvent_Player{Player: m}
+	case *systerapb.PunishmentStream:
+		event.Event = &systerapb.StreamEvent_Punishment{Punishment: m}
+	case *systerapb.GroupStream:
+		event.Event = &systerapb.StreamEvent_Group{Group: m}
+	case *systerapb.ChatStream:
+		event.Event = &systerapb.StreamEvent_Chat{Chat: m}
+	case *systerapb.ServerStream:
+		event.Event = &systerapb.StreamEvent_Server{Server: m}
+	}
+	return event
+}
@@ -0,0 +1,77 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/synchthia/systera-api/systerapb"
+)
+
+// drain - Events buffered in subscription
+func drain(sub *Subscription) []*systerapb.StreamEvent {
+	var events []*systerapb.StreamEvent
+	for {
+		select {
+		case e, ok := <-sub.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	s := New(NewInProcessPublisher(), nil)
+	lobby := s.Subscribe("lobby", nil)
+	chat := s.Subscribe("skywars-1", []systerapb.StreamTopic{systerapb.StreamTopic_CHAT})
+	defer lobby.Close()
+	defer chat.Close()
+
+	s.PublishAnnounce("global", "to all")
+	s.PublishAnnounce("lobby", "to lobby")
+	s.PublishAnnounce("skywars-1", "to skywars")
+	s.PublishChat(&systerapb.ChatEntry{Message: "hello"})
+
+	for _, tc := range []struct {
+		name string
+		sub  *Subscription
+		want []string
+	}{
+		{"lobby", lobby, []string{"systera.system.global", "systera.system.lobby", "systera.chat.global"}},
+		{"chat only", chat, []string{"systera.chat.global"}},
+	} {
+		events := drain(tc.sub)
+		if len(events) != len(tc.want) {
+			t.Errorf("%s: %d events, want %v", tc.name, len(events), tc.want)
+			continue
+		}
+		for i, e := range events {
+			if e.Channel != tc.want[i] {
+				t.Errorf("%s: event %d on %s, want %s", tc.name, i, e.Channel, tc.want[i])
+			}
+		}
+	}
+}
+
+func TestSubscribeClose(t *testing.T) {
+	s := New(NewInProcessPublisher(), nil)
+
+	sub := s.Subscribe("lobby", nil)
+	sub.Close()
+	sub.Close()
+	if _, ok := <-sub.Events(); ok || sub.Err() != nil {
+		t.Fatalf("closed subscription: err = %v", sub.Err())
+	}
+	s.PublishAnnounce("global", "after close")
+
+	// Subscriber not reading is closed once its buffer is full
+	slow := s.Subscribe("lobby", nil)
+	for i := 0; i <= subscriberBuffer; i++ {
+		s.PublishAnnounce("global", "flood")
+	}
+	if events := drain(slow); len(events) != subscriberBuffer || slow.Err() != ErrSubscriberTooSlow {
+		t.Fatalf("slow subscriber: %d events, err = %v", len(events), slow.Err())
+	}
+}
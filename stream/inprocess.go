@@ -0,0 +1,72 @@
+package stream
+
+import (
+	"strings"
+	"sync"
+)
+
+// Message - Published message (InProcessPublisher)
+type Message struct {
+	Channel string
+	Payload []byte
+}
+
+// InProcessPublisher - Publish to in-process listeners (tests / single-node setups)
+type InProcessPublisher struct {
+	mu        sync.RWMutex
+	listeners map[chan Message]string
+}
+
+// NewInProcessPublisher - Create in-process publisher
+func NewInProcessPublisher() *InProcessPublisher {
+	return &InProcessPublisher{
+		listeners: make(map[chan Message]string),
+	}
+}
+
+// Listen - Receive messages whose channel starts with prefix ("" for all)
+func (p *InProcessPublisher) Listen(prefix string, buffer int) (<-chan Message, func()) {
+	ch := make(chan Message, buffer)
+
+	p.mu.Lock()
+	p.listeners[ch] = prefix
+	p.mu.Unlock()
+
+	return ch, func() {
+		p.mu.Lock()
+		if _, ok := p.listeners[ch]; ok {
+			delete(p.listeners, ch)
+			close(ch)
+		}
+		p.mu.Unlock()
+	}
+}
+
+// Publish - Deliver payload to listeners (dropped if listener buffer is full)
+func (p *InProcessPublisher) Publish(channel string, payload []byte) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for ch, prefix := range p.listeners {
+		if !strings.HasPrefix(channel, prefix) {
+			continue
+		}
+		select {
+		case ch <- Message{Channel: channel, Payload: payload}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Close - Close all listeners
+func (p *InProcessPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for ch := range p.listeners {
+		delete(p.listeners, ch)
+		close(ch)
+	}
+	return nil
+}
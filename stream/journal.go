@@ -0,0 +1,158 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/synchthia/systera-api/systerapb"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrJournalDisabled - Replay / Ack called without Journal
+var ErrJournalDisabled = errors.New("stream journal is disabled")
+
+// Journal - Durable event log with per consumer group acknowledgement
+type Journal interface {
+	// Append - Append payload published on channel
+	Append(channel string, payload []byte) (string, error)
+	// Replay - Unacknowledged entries of channel for group (pending first, then new)
+	Replay(group, channel string, count int) ([]JournalEntry, error)
+	// Ack - Acknowledge entries of channel for group
+	Ack(group, channel string, ids ...string) error
+}
+
+// JournalEntry - Entry stored in Journal
+type JournalEntry struct {
+	ID      string
+	Channel string
+	Payload []byte
+}
+
+// JournalConfig - Journal retention
+type JournalConfig struct {
+	// MaxLen - Approximate max entries per channel
+	MaxLen int64
+	// TopicMaxLen - MaxLen override per topic (ex. "punishment")
+	TopicMaxLen map[string]int64
+}
+
+func (c JournalConfig) maxLen(channel string) int64 {
+	if n, ok := c.TopicMaxLen[topicOf(channel)]; ok {
+		return n
+	}
+	return c.MaxLen
+}
+
+// Replay - Unacknowledged events for server (global and server targeted channels)
+func (s *Stream) Replay(serverName string, topics []systerapb.StreamTopic, limit int) ([]*systerapb.JournalEvent, error) {
+	if s.journal == nil {
+		return nil, ErrJournalDisabled
+	}
+
+	if len(topics) == 0 {
+		for v := range systerapb.StreamTopic_name {
+			topics = append(topics, systerapb.StreamTopic(v))
+		}
+	}
+
+	var entries []JournalEntry
+	for _, t := range topics {
+		topic := strings.ToLower(t.String())
+		for _, target := range []string{"global", serverName} {
+			e, err := s.journal.Replay(serverName, "systera."+topic+"."+target, limit)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e...)
+		}
+	}
+
+	// Order by entry ID (<millis>-<seq>) across channels
+	sort.SliceStable(entries, func(i, j int) bool {
+		return lessID(entries[i].ID, entries[j].ID)
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	var events []*systerapb.JournalEvent
+	for _, e := range entries {
+		event, err := DecodeEvent(e.Channel, e.Payload)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &systerapb.JournalEvent{
+			Id:    e.ID,
+			Event: event,
+		})
+	}
+	return events, nil
+}
+
+// Ack - Acknowledge replayed events
+func (s *Stream) Ack(serverName, channel string, ids []string) error {
+	if s.journal == nil {
+		return ErrJournalDisabled
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return s.journal.Ack(serverName, channel, ids...)
+}
+
+// DecodeEvent - Decode payload (any encoding) published on channel
+func DecodeEvent(channel string, payload []byte) (*systerapb.StreamEvent, error) {
+	var d proto.Message
+	switch topicOf(channel) {
+	case "system":
+		d = &systerapb.SystemStream{}
+	case "player":
+		d = &systerapb.PlayerStream{}
+	case "punishment":
+		d = &systerapb.PunishmentStream{}
+	case "group":
+		d = &systerapb.GroupStream{}
+	case "chat":
+		d = &systerapb.ChatStream{}
+	case "server":
+		d = &systerapb.ServerStream{}
+	default:
+		return nil, fmt.Errorf("unknown channel: %s", channel)
+	}
+
+	if err := unmarshalPayload(payload, d); err != nil {
+		return nil, err
+	}
+	return toEvent(channel, d), nil
+}
+
+// topicOf - systera.<topic>.<target> -> topic
+func topicOf(channel string) string {
+	parts := strings.SplitN(channel, ".", 3)
+	if len(parts) != 3 {
+		return ""
+	}
+	return parts[1]
+}
+
+func lessID(a, b string) bool {
+	am, as := splitID(a)
+	bm, bs := splitID(b)
+	if am != bm {
+		return am < bm
+	}
+	return as < bs
+}
+
+func splitID(id string) (uint64, uint64) {
+	parts := strings.SplitN(id, "-", 2)
+	ms, _ := strconv.ParseUint(parts[0], 10, 64)
+	var seq uint64
+	if len(parts) == 2 {
+		seq, _ = strconv.ParseUint(parts[1], 10, 64)
+	}
+	return ms, seq
+}
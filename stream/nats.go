@@ -0,0 +1,37 @@
+package stream
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/sirupsen/logrus"
+)
+
+// NatsPublisher - Publish via NATS (channel is used as subject)
+type NatsPublisher struct {
+	conn *nats.Conn
+}
+
+// NewNatsPublisher - Connect to NATS
+func NewNatsPublisher(url string) (*NatsPublisher, error) {
+	logrus.WithField("url", url).Infof("[NATS] Connecting to NATS...")
+
+	conn, err := nats.Connect(url,
+		nats.Name("systera-api"),
+		nats.MaxReconnects(-1),
+	)
+	if err != nil {
+		logrus.WithError(err).Errorf("[NATS] Failed to connect: %s", url)
+		return nil, err
+	}
+
+	return &NatsPublisher{conn: conn}, nil
+}
+
+// Publish - Publish payload to subject
+func (n *NatsPublisher) Publish(channel string, payload []byte) error {
+	return n.conn.Publish(channel, payload)
+}
+
+// Close - Flush pending messages and close connection
+func (n *NatsPublisher) Close() error {
+	return n.conn.Drain()
+}
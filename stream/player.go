@@ -1,26 +1,18 @@
 package stream
 
 import (
-	"encoding/json"
-
 	"github.com/synchthia/systera-api/systerapb"
 
 	"github.com/sirupsen/logrus"
 )
 
 // PublishPlayerGroups - Publish Player Groups
-func PublishPlayerGroups(target string, data *systerapb.PlayerEntry) {
-	c := pool.Get()
-	defer c.Close()
-
+func (s *Stream) PublishPlayerGroups(target string, data *systerapb.PlayerEntry) {
 	d := &systerapb.PlayerStream{
 		Type:  systerapb.PlayerStream_GROUPS,
 		Entry: data,
 	}
-	serialized, _ := json.Marshal(&d)
-	logrus.Debugln(d)
-
-	_, err := c.Do("PUBLISH", "systera.player."+target, string(serialized))
+	err := s.publish("systera.player."+target, d)
 	if err != nil {
 		logrus.WithError(err).Errorf("[Publish] Failed Publish Player")
 	}
@@ -0,0 +1,45 @@
+package stream
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Publisher - Message broker transport for systera.* channels
+type Publisher interface {
+	Publish(channel string, payload []byte) error
+	Close() error
+}
+
+// Config - Publisher selection
+type Config struct {
+	// Broker - redis / inprocess / nats
+	Broker       string
+	RedisAddress string
+	NatsURL      string
+}
+
+// NewPublisher - Create Publisher from config
+func NewPublisher(c Config) (Publisher, error) {
+	if c.Broker == "" {
+		c.Broker = "redis"
+	}
+	logrus.WithField("broker", c.Broker).Infof("[Stream] Using %s broker", c.Broker)
+
+	switch c.Broker {
+	case "redis":
+		return NewRedisPublisher(c.RedisAddress), nil
+	case "inprocess":
+		return NewInProcessPublisher(), nil
+	case "nats":
+		// Return untyped nil on error (not nil *NatsPublisher)
+		p, err := NewNatsPublisher(c.NatsURL)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	default:
+		return nil, fmt.Errorf("unknown broker: %s", c.Broker)
+	}
+}
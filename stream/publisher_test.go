@@ -0,0 +1,80 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+func TestNewPublisher(t *testing.T) {
+	for _, tc := range []struct {
+		config Config
+		err    bool
+	}{
+		{Config{Broker: "inprocess"}, false},
+		{Config{RedisAddress: "localhost:6379"}, false}, // default: redis (dials lazily)
+		{Config{Broker: "nats", NatsURL: "nats://127.0.0.1:1"}, true},
+		{Config{Broker: "kafka"}, true},
+	} {
+		p, err := NewPublisher(tc.config)
+		if (err != nil) != tc.err {
+			t.Errorf("NewPublisher(%+v): err = %v", tc.config, err)
+		}
+		if p != nil {
+			p.Close()
+		}
+	}
+}
+
+func TestInProcessPublisher(t *testing.T) {
+	p := NewInProcessPublisher()
+	all, stopAll := p.Listen("", 8)
+	system, stopSystem := p.Listen("systera.system.", 1)
+	defer stopAll()
+
+	p.Publish("systera.system.global", []byte("1"))
+	p.Publish("systera.chat.global", []byte("2"))
+	// system listener buffer is full: dropped for it only
+	p.Publish("systera.system.lobby", []byte("3"))
+
+	for _, want := range []string{"1", "2", "3"} {
+		if m := <-all; string(m.Payload) != want {
+			t.Fatalf("all: %s, want %s", m.Payload, want)
+		}
+	}
+	if m := <-system; m.Channel != "systera.system.global" {
+		t.Fatalf("system: %+v", m)
+	}
+	select {
+	case m := <-system:
+		t.Fatalf("system listener received %+v after buffer was full", m)
+	default:
+	}
+
+	stopSystem()
+	stopSystem()
+	if _, ok := <-system; ok {
+		t.Fatal("stopped listener still open")
+	}
+	p.Publish("systera.system.global", []byte("4"))
+
+	p.Close()
+	if m, ok := <-all; !ok || string(m.Payload) != "4" {
+		t.Fatalf("all after stop of other listener: %+v %v", m, ok)
+	}
+	if _, ok := <-all; ok {
+		t.Fatal("listener open after Close")
+	}
+}
+
+func TestRedisPublisher(t *testing.T) {
+	c := &scriptedConn{}
+	p := &RedisPublisher{pool: &redis.Pool{Dial: func() (redis.Conn, error) { return c, nil }}}
+
+	if err := p.Publish("proto:systera.system.global", []byte("payload")); err != nil {
+		t.Fatal(err)
+	}
+	if c.last() != "PUBLISH proto:systera.system.global payload" {
+		t.Fatalf("command: %s", c.last())
+	}
+}
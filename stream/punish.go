@@ -1,17 +1,12 @@
 package stream
 
 import (
-	"encoding/json"
-
 	"github.com/sirupsen/logrus"
 	"github.com/synchthia/systera-api/systerapb"
 )
 
 // PublishPunish - Publish Punish
-func PublishPunish(remote bool, data *systerapb.PunishEntry) {
-	c := pool.Get()
-	defer c.Close()
-
+func (s *Stream) PublishPunish(remote bool, data *systerapb.PunishEntry) {
 	d := &systerapb.PunishmentStream{
 		Type: systerapb.PunishmentStream_PUNISH,
 		PunishStreamEntry: &systerapb.PunishStreamEntry{
@@ -19,29 +14,32 @@ func PublishPunish(remote bool, data *systerapb.PunishEntry) {
 			RequireExecute: remote,
 		},
 	}
-	serialized, _ := json.Marshal(&d)
-	logrus.Debugln(d)
-
-	_, err := c.Do("PUBLISH", "systera.punishment.global", string(serialized))
+	err := s.publish("systera.punishment.global", d)
 	if err != nil {
 		logrus.WithError(err).Errorf("[Publish] Failed Publish Punishment")
 	}
 }
 
 // PublishReport - Publish Report
-func PublishReport(data *systerapb.ReportEntry) {
-	c := pool.Get()
-	defer c.Close()
-
+func (s *Stream) PublishReport(data *systerapb.ReportEntry) {
 	d := &systerapb.PunishmentStream{
 		Type:        systerapb.PunishmentStream_REPORT,
 		ReportEntry: data,
 	}
-	serialized, _ := json.Marshal(&d)
-	logrus.Debugln(d)
-
-	_, err := c.Do("PUBLISH", "systera.punishment.global", string(serialized))
+	err := s.publish("systera.punishment.global", d)
 	if err != nil {
 		logrus.WithError(err).Errorf("[Publish] Failed Publish Report")
 	}
 }
+
+// PublishAppeal - Publish Appeal (submitted / reviewed)
+func (s *Stream) PublishAppeal(data *systerapb.AppealEntry) {
+	d := &systerapb.PunishmentStream{
+		Type:        systerapb.PunishmentStream_APPEAL,
+		AppealEntry: data,
+	}
+	err := s.publish("systera.punishment.global", d)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Publish] Failed Publish Appeal")
+	}
+}
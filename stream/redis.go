@@ -7,15 +7,23 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-var pool *redis.Pool
+// RedisPublisher - Publish via Redis PUBLISH
+type RedisPublisher struct {
+	pool *redis.Pool
+}
+
+// NewRedisPublisher - Create Redis publisher
+func NewRedisPublisher(server string) *RedisPublisher {
+	return &RedisPublisher{pool: newRedisPool(server)}
+}
 
-// NewRedisPool - redis Connection Pooling
-func NewRedisPool(server string) {
+// newRedisPool - redis Connection Pooling
+func newRedisPool(server string) *redis.Pool {
 	logrus.WithFields(logrus.Fields{
 		"server": server,
 	}).Infof("[Redis] Creating Pool...")
 
-	pool = &redis.Pool{
+	return &redis.Pool{
 		MaxIdle:   12,
 		MaxActive: 0,
 		//IdleTimeout: 240 * time.Second,
@@ -41,3 +49,17 @@ func NewRedisPool(server string) {
 		},
 	}
 }
+
+// Publish - PUBLISH payload to channel
+func (r *RedisPublisher) Publish(channel string, payload []byte) error {
+	c := r.pool.Get()
+	defer c.Close()
+
+	_, err := c.Do("PUBLISH", channel, payload)
+	return err
+}
+
+// Close - Close connection pool
+func (r *RedisPublisher) Close() error {
+	return r.pool.Close()
+}
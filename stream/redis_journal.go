@@ -0,0 +1,149 @@
+package stream
+
+import (
+	"strings"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/sirupsen/logrus"
+)
+
+// RedisJournal - Journal on Redis Streams (XADD / XREADGROUP / XACK)
+// Each channel is stored in "journal:<channel>" and each server is a consumer group.
+type RedisJournal struct {
+	pool   *redis.Pool
+	config JournalConfig
+}
+
+// NewRedisJournal - Create Redis Streams journal
+func NewRedisJournal(server string, config JournalConfig) *RedisJournal {
+	return &RedisJournal{
+		pool:   newRedisPool(server),
+		config: config,
+	}
+}
+
+func journalKey(channel string) string {
+	return "journal:" + channel
+}
+
+// Append - XADD payload (trimmed to MaxLen of topic)
+func (r *RedisJournal) Append(channel string, payload []byte) (string, error) {
+	c := r.pool.Get()
+	defer c.Close()
+
+	args := redis.Args{journalKey(channel)}
+	if n := r.config.maxLen(channel); n > 0 {
+		args = args.Add("MAXLEN", "~", n)
+	}
+	args = args.Add("*", "channel", channel, "payload", payload)
+
+	id, err := redis.String(c.Do("XADD", args...))
+	if err != nil {
+		logrus.WithError(err).Errorf("[Journal] Failed XADD: %s", channel)
+		return "", err
+	}
+	return id, nil
+}
+
+// Replay - Pending entries of group, then entries never delivered to group
+func (r *RedisJournal) Replay(group, channel string, count int) ([]JournalEntry, error) {
+	c := r.pool.Get()
+	defer c.Close()
+
+	key := journalKey(channel)
+
+	// New group starts after the last entry: a server seen for the first time must not
+	// re-run retained DISPATCH / require_execute events meant for earlier servers
+	if _, err := c.Do("XGROUP", "CREATE", key, group, "$", "MKSTREAM"); err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		logrus.WithError(err).Errorf("[Journal] Failed XGROUP CREATE: %s (%s)", channel, group)
+		return nil, err
+	}
+
+	// "0": delivered but not acknowledged / ">": not delivered yet
+	var entries []JournalEntry
+	for _, from := range []string{"0", ">"} {
+		args := redis.Args{"GROUP", group, group}
+		if count > 0 {
+			args = args.Add("COUNT", count-len(entries))
+		}
+		args = args.Add("STREAMS", key, from)
+
+		e, trimmed, err := readEntries(c.Do("XREADGROUP", args...))
+		if err != nil {
+			logrus.WithError(err).Errorf("[Journal] Failed XREADGROUP: %s (%s)", channel, group)
+			return nil, err
+		}
+		entries = append(entries, e...)
+
+		// Pending entries already trimmed by MAXLEN can never be delivered
+		if len(trimmed) != 0 {
+			if _, err := c.Do("XACK", redis.Args{key, group}.AddFlat(trimmed)...); err != nil {
+				return nil, err
+			}
+		}
+
+		if count > 0 && len(entries) >= count {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+// Ack - XACK entries
+func (r *RedisJournal) Ack(group, channel string, ids ...string) error {
+	c := r.pool.Get()
+	defer c.Close()
+
+	_, err := c.Do("XACK", redis.Args{journalKey(channel), group}.AddFlat(ids)...)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Journal] Failed XACK: %s (%s)", channel, group)
+	}
+	return err
+}
+
+// readEntries - Parse XREADGROUP reply: [[key, [[id, [field, value, ...]], ...]]]
+// Returns entries and IDs of trimmed (deleted) entries.
+func readEntries(reply interface{}, err error) ([]JournalEntry, []string, error) {
+	if err == redis.ErrNil || (err == nil && reply == nil) {
+		return nil, nil, nil
+	}
+	streams, err := redis.Values(reply, err)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries []JournalEntry
+	var trimmed []string
+	for _, s := range streams {
+		kv, err := redis.Values(s, nil)
+		if err != nil || len(kv) != 2 {
+			continue
+		}
+		items, err := redis.Values(kv[1], nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, item := range items {
+			pair, err := redis.Values(item, nil)
+			if err != nil || len(pair) != 2 {
+				continue
+			}
+			id, _ := redis.String(pair[0], nil)
+			fields, _ := redis.StringMap(pair[1], nil)
+
+			// Deleted (trimmed) entries have no fields
+			if fields == nil {
+				trimmed = append(trimmed, id)
+				continue
+			}
+			entries = append(entries, JournalEntry{
+				ID:      id,
+				Channel: fields["channel"],
+				Payload: []byte(fields["payload"]),
+			})
+		}
+	}
+	return entries, trimmed, nil
+}
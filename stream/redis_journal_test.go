@@ -0,0 +1,194 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// scriptedConn - redis.Conn recording commands and answering with reply
+type scriptedConn struct {
+	commands []string
+	reply    func(cmd string, args []interface{}) (interface{}, error)
+}
+
+func (c *scriptedConn) Do(cmd string, args ...interface{}) (interface{}, error) {
+	if cmd == "" {
+		return nil, nil
+	}
+
+	parts := []string{cmd}
+	for _, a := range args {
+		if b, ok := a.([]byte); ok {
+			a = string(b)
+		}
+		parts = append(parts, fmt.Sprint(a))
+	}
+	c.commands = append(c.commands, strings.Join(parts, " "))
+
+	if c.reply == nil {
+		return "OK", nil
+	}
+	return c.reply(cmd, args)
+}
+
+func (c *scriptedConn) Close() error                      { return nil }
+func (c *scriptedConn) Err() error                        { return nil }
+func (c *scriptedConn) Send(string, ...interface{}) error { return nil }
+func (c *scriptedConn) Flush() error                      { return nil }
+func (c *scriptedConn) Receive() (interface{}, error)     { return nil, nil }
+func (c *scriptedConn) last() string                      { return c.commands[len(c.commands)-1] }
+func (c *scriptedConn) reset()                            { c.commands = nil }
+
+// newScriptedJournal - RedisJournal on scriptedConn
+func newScriptedJournal(config JournalConfig) (*RedisJournal, *scriptedConn) {
+	c := &scriptedConn{}
+	pool := &redis.Pool{Dial: func() (redis.Conn, error) { return c, nil }}
+	return &RedisJournal{pool: pool, config: config}, c
+}
+
+// streamReply - XREADGROUP reply for key (nil fields: trimmed entry)
+func streamReply(key string, entries ...[]interface{}) interface{} {
+	var items []interface{}
+	for _, e := range entries {
+		items = append(items, e)
+	}
+	return []interface{}{[]interface{}{[]byte(key), items}}
+}
+
+func entry(id, channel, payload string) []interface{} {
+	return []interface{}{[]byte(id), []interface{}{[]byte("channel"), []byte(channel), []byte("payload"), []byte(payload)}}
+}
+
+func TestRedisJournalAppendMaxLen(t *testing.T) {
+	j, c := newScriptedJournal(JournalConfig{MaxLen: 10000, TopicMaxLen: map[string]int64{"punishment": 100, "chat": 0}})
+	c.reply = func(string, []interface{}) (interface{}, error) { return []byte("1-0"), nil }
+
+	for channel, want := range map[string]string{
+		"systera.system.global":    "XADD journal:systera.system.global MAXLEN ~ 10000 * channel systera.system.global payload p",
+		"systera.punishment.lobby": "XADD journal:systera.punishment.lobby MAXLEN ~ 100 * channel systera.punishment.lobby payload p",
+		"systera.chat.global":      "XADD journal:systera.chat.global * channel systera.chat.global payload p",
+	} {
+		c.reset()
+		if id, err := j.Append(channel, []byte("p")); err != nil || id != "1-0" {
+			t.Fatalf("Append(%s): %q %v", channel, id, err)
+		}
+		if c.last() != want {
+			t.Errorf("Append(%s):\n got %s\nwant %s", channel, c.last(), want)
+		}
+	}
+}
+
+func TestRedisJournalReplay(t *testing.T) {
+	const channel = "systera.system.global"
+	key := journalKey(channel)
+
+	j, c := newScriptedJournal(JournalConfig{})
+	c.reply = func(cmd string, args []interface{}) (interface{}, error) {
+		switch {
+		case cmd == "XGROUP":
+			return nil, errors.New("BUSYGROUP Consumer Group name already exists")
+		case cmd == "XREADGROUP" && args[len(args)-1] == "0":
+			return streamReply(key, entry("1-0", channel, "pending"), []interface{}{[]byte("1-1"), nil}), nil
+		case cmd == "XREADGROUP":
+			return streamReply(key, entry("2-0", channel, "new")), nil
+		}
+		return int64(1), nil
+	}
+
+	entries, err := j.Replay("lobby", channel, 5)
+	if err != nil || len(entries) != 2 || string(entries[0].Payload) != "pending" || entries[1].ID != "2-0" {
+		t.Fatalf("Replay: %+v %v", entries, err)
+	}
+	want := []string{
+		"XGROUP CREATE journal:systera.system.global lobby $ MKSTREAM",
+		"XREADGROUP GROUP lobby lobby COUNT 5 STREAMS journal:systera.system.global 0",
+		// Trimmed pending entry can never be delivered
+		"XACK journal:systera.system.global lobby 1-1",
+		"XREADGROUP GROUP lobby lobby COUNT 4 STREAMS journal:systera.system.global >",
+	}
+	if strings.Join(c.commands, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("commands:\n%s\nwant:\n%s", strings.Join(c.commands, "\n"), strings.Join(want, "\n"))
+	}
+
+	c.reset()
+	if err := j.Ack("lobby", channel, "1-0", "2-0"); err != nil {
+		t.Fatal(err)
+	}
+	if c.last() != "XACK journal:systera.system.global lobby 1-0 2-0" {
+		t.Fatalf("Ack: %s", c.last())
+	}
+}
+
+func TestRedisJournalReplayNil(t *testing.T) {
+	j, c := newScriptedJournal(JournalConfig{})
+	c.reply = func(cmd string, args []interface{}) (interface{}, error) {
+		if cmd == "XREADGROUP" {
+			return nil, redis.ErrNil
+		}
+		return "OK", nil
+	}
+	if entries, err := j.Replay("lobby", "systera.system.global", 0); err != nil || len(entries) != 0 {
+		t.Fatalf("Replay(empty): %+v %v", entries, err)
+	}
+}
+
+// TestRedisJournal - Against real Redis (SYSTERA_TEST_REDIS_ADDR, ex. localhost:6379)
+func TestRedisJournal(t *testing.T) {
+	addr := os.Getenv("SYSTERA_TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("SYSTERA_TEST_REDIS_ADDR is not set")
+	}
+
+	suffix := fmt.Sprint(time.Now().UnixNano())
+	channel := "systera.system.test-" + suffix
+	trimmed := "systera.chat.test-" + suffix
+	j := NewRedisJournal(addr, JournalConfig{TopicMaxLen: map[string]int64{"chat": 10}})
+	t.Cleanup(func() {
+		c := j.pool.Get()
+		c.Do("DEL", journalKey(channel), journalKey(trimmed))
+		c.Close()
+		j.pool.Close()
+	})
+
+	// Entries journaled before the group exists are not replayed
+	if _, err := j.Append(channel, []byte("old")); err != nil {
+		t.Fatal(err)
+	}
+	if entries, err := j.Replay("lobby", channel, 0); err != nil || len(entries) != 0 {
+		t.Fatalf("Replay(new group): %+v %v", entries, err)
+	}
+
+	j.Append(channel, []byte("e1"))
+	j.Append(channel, []byte("e2"))
+	entries, err := j.Replay("lobby", channel, 1)
+	if err != nil || len(entries) != 1 || string(entries[0].Payload) != "e1" {
+		t.Fatalf("Replay(count 1): %+v %v", entries, err)
+	}
+
+	// Unacknowledged e1 is replayed again, before e2
+	entries, _ = j.Replay("lobby", channel, 0)
+	if len(entries) != 2 || string(entries[0].Payload) != "e1" || string(entries[1].Payload) != "e2" {
+		t.Fatalf("Replay(pending): %+v", entries)
+	}
+	if err := j.Ack("lobby", channel, entries[0].ID, entries[1].ID); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := j.Replay("lobby", channel, 0); len(entries) != 0 {
+		t.Fatalf("Replay(acked): %+v", entries)
+	}
+
+	for i := 0; i < 1000; i++ {
+		j.Append(trimmed, []byte("x"))
+	}
+	c := j.pool.Get()
+	defer c.Close()
+	if n, err := redis.Int(c.Do("XLEN", journalKey(trimmed))); err != nil || n >= 1000 {
+		t.Fatalf("XLEN with topic MAXLEN 10: %d %v", n, err)
+	}
+}
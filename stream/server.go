@@ -0,0 +1,18 @@
+package stream
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/systerapb"
+)
+
+// PublishServer - Publish Server up / down
+func (s *Stream) PublishServer(t systerapb.ServerStream_Type, entry *systerapb.ServerEntry) {
+	d := &systerapb.ServerStream{
+		Type:  t,
+		Entry: entry,
+	}
+	err := s.publish("systera.server.global", d)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Publish] Failed Publish server")
+	}
+}
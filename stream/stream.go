@@ -0,0 +1,77 @@
+package stream
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/systera-api/metrics"
+	"google.golang.org/protobuf/proto"
+)
+
+// Stream - Publish systera.* events to Publisher, Journal and Subscribe clients
+type Stream struct {
+	publisher Publisher
+	journal   Journal
+	encodings []Encoding
+	hub       *hub
+}
+
+// New - Create Stream with Publisher (journal is optional)
+// Messages are published once per encoding (default: legacy JSON only);
+// the journal stores each message once, as proto envelope.
+func New(publisher Publisher, journal Journal, encodings ...Encoding) *Stream {
+	if len(encodings) == 0 {
+		encodings = []Encoding{EncodingJSON}
+	}
+
+	return &Stream{
+		publisher: publisher,
+		journal:   journal,
+		encodings: encodings,
+		hub:       newHub(),
+	}
+}
+
+// Close - Close Publisher
+func (s *Stream) Close() error {
+	return s.publisher.Close()
+}
+
+// publish - Deliver message to in-process subscribers, Journal and Publisher
+// Journal first, then publish: an event whose publish fails is still replayed by Replay.
+func (s *Stream) publish(channel string, d proto.Message) error {
+	s.hub.dispatch(channel, d)
+	logrus.Debugln(d)
+
+	var err error
+	if s.journal != nil {
+		err = s.append(channel, d)
+	}
+
+	for _, encoding := range s.encodings {
+		serialized, merr := encoding.Marshal(d)
+		if merr != nil {
+			logrus.WithError(merr).Errorf("[Stream] Failed to encode %s (%s)", channel, encoding)
+			if err == nil {
+				err = merr
+			}
+			continue
+		}
+
+		perr := s.publisher.Publish(encoding.Channel(channel), serialized)
+		metrics.ObservePublish(topicOf(channel), perr)
+		if perr != nil && err == nil {
+			err = perr
+		}
+	}
+	return err
+}
+
+// append - Journal message as proto envelope (decoded by DecodeEvent on Replay)
+func (s *Stream) append(channel string, d proto.Message) error {
+	payload, err := MarshalEnvelope(d)
+	if err != nil {
+		logrus.WithError(err).Errorf("[Stream] Failed to encode journal entry %s", channel)
+		return err
+	}
+	_, err = s.journal.Append(channel, payload)
+	return err
+}
@@ -0,0 +1,85 @@
+package stream
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestMain(m *testing.M) {
+	logrus.SetOutput(ioutil.Discard)
+	os.Exit(m.Run())
+}
+
+// recorder - Publisher and Journal appending calls to shared log
+type recorder struct {
+	log        []string
+	journaled  [][]byte
+	publishErr error
+	journalErr error
+}
+
+func (r *recorder) Publish(channel string, payload []byte) error {
+	r.log = append(r.log, "publish "+channel)
+	return r.publishErr
+}
+
+func (r *recorder) Close() error { return nil }
+
+func (r *recorder) Append(channel string, payload []byte) (string, error) {
+	r.log = append(r.log, "journal "+channel)
+	r.journaled = append(r.journaled, payload)
+	return "1-0", r.journalErr
+}
+
+func (r *recorder) Replay(group, channel string, count int) ([]JournalEntry, error) {
+	return nil, nil
+}
+
+func (r *recorder) Ack(group, channel string, ids ...string) error {
+	return nil
+}
+
+func TestPublishJournalsOnce(t *testing.T) {
+	r := &recorder{}
+	s := New(r, r, EncodingJSON, EncodingProto)
+
+	if err := s.PublishAnnounce("global", "hi"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"journal systera.system.global",
+		"publish systera.system.global",
+		"publish proto:systera.system.global",
+	}
+	if strings.Join(r.log, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("calls:\n%s\nwant:\n%s", strings.Join(r.log, "\n"), strings.Join(want, "\n"))
+	}
+
+	// Journal entry is the envelope read back by DecodeEvent, whatever the first encoding is
+	event, err := DecodeEvent("systera.system.global", r.journaled[0])
+	if err != nil || event.GetSystem().GetMsg() != "hi" || !isEnvelope(r.journaled[0]) {
+		t.Fatalf("journal entry: %q %v", r.journaled[0], err)
+	}
+}
+
+func TestPublishErrors(t *testing.T) {
+	r := &recorder{publishErr: errors.New("broker down")}
+	s := New(r, r)
+
+	// Failed publish is journaled (before publish) and reported
+	if err := s.PublishAnnounce("global", "hi"); err != r.publishErr || len(r.journaled) != 1 {
+		t.Fatalf("publish error: %v, journaled %d", err, len(r.journaled))
+	}
+
+	// Failed journal does not prevent publish
+	r = &recorder{journalErr: errors.New("journal down")}
+	s = New(r, r)
+	if err := s.PublishAnnounce("global", "hi"); err != r.journalErr || r.log[len(r.log)-1] != "publish systera.system.global" {
+		t.Fatalf("journal error: %v, calls %v", err, r.log)
+	}
+}
@@ -1,25 +1,17 @@
 package stream
 
 import (
-	"encoding/json"
-
 	"github.com/sirupsen/logrus"
 	"github.com/synchthia/systera-api/systerapb"
 )
 
 // PublishAnnounce - Publish Server Announce
-func PublishAnnounce(target, msg string) error {
-	c := pool.Get()
-	defer c.Close()
-
+func (s *Stream) PublishAnnounce(target, msg string) error {
 	d := &systerapb.SystemStream{
 		Type: systerapb.SystemStream_ANNOUNCE,
 		Msg:  msg,
 	}
-	serialized, _ := json.Marshal(&d)
-	logrus.Debugln(d)
-
-	_, err := c.Do("PUBLISH", "systera.system."+target, string(serialized))
+	err := s.publish("systera.system."+target, d)
 	if err != nil {
 		logrus.WithError(err).Errorf("[Publish] Failed Publish Announce")
 		return err
@@ -27,19 +19,14 @@ func PublishAnnounce(target, msg string) error {
 	return nil
 }
 
-// PublishCommand - Publish Server Command
-func PublishCommand(target, cmd string) error {
-	c := pool.Get()
-	defer c.Close()
-
+// PublishCommand - Publish Server Command (id: correlation ID for results)
+func (s *Stream) PublishCommand(target, cmd, id string) error {
 	d := &systerapb.SystemStream{
 		Type: systerapb.SystemStream_DISPATCH,
 		Msg:  cmd,
+		Id:   id,
 	}
-	serialized, _ := json.Marshal(&d)
-	logrus.Debugln(d)
-
-	_, err := c.Do("PUBLISH", "systera.system."+target, string(serialized))
+	err := s.publish("systera.system."+target, d)
 	if err != nil {
 		logrus.WithError(err).Errorf("[Publish] Failed Publish Command")
 		return err
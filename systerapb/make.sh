@@ -5,7 +5,7 @@ BASEMENT=$PWD
 
 export PATH="$PATH:$(go env GOPATH)/bin"
 # https://grpc.io/docs/languages/go/quickstart/
-go install -v google.golang.org/protobuf/cmd/protoc-gen-go@v1.28
+go install -v google.golang.org/protobuf/cmd/protoc-gen-go@v1.30
 go install -v google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.2
 
 echo "Generating Go Protoc..."
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.30.0
 // 	protoc        v4.25.3
 // source: systera.proto
 
@@ -70,6 +70,116 @@ func (CallResult) EnumDescriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{0}
 }
 
+type StreamTopic int32
+
+const (
+	StreamTopic_SYSTEM     StreamTopic = 0
+	StreamTopic_PLAYER     StreamTopic = 1
+	StreamTopic_PUNISHMENT StreamTopic = 2
+	StreamTopic_GROUP      StreamTopic = 3
+	StreamTopic_CHAT       StreamTopic = 4
+	StreamTopic_SERVER     StreamTopic = 5
+)
+
+// Enum value maps for StreamTopic.
+var (
+	StreamTopic_name = map[int32]string{
+		0: "SYSTEM",
+		1: "PLAYER",
+		2: "PUNISHMENT",
+		3: "GROUP",
+		4: "CHAT",
+		5: "SERVER",
+	}
+	StreamTopic_value = map[string]int32{
+		"SYSTEM":     0,
+		"PLAYER":     1,
+		"PUNISHMENT": 2,
+		"GROUP":      3,
+		"CHAT":       4,
+		"SERVER":     5,
+	}
+)
+
+func (x StreamTopic) Enum() *StreamTopic {
+	p := new(StreamTopic)
+	*p = x
+	return p
+}
+
+func (x StreamTopic) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StreamTopic) Descriptor() protoreflect.EnumDescriptor {
+	return file_systera_proto_enumTypes[1].Descriptor()
+}
+
+func (StreamTopic) Type() protoreflect.EnumType {
+	return &file_systera_proto_enumTypes[1]
+}
+
+func (x StreamTopic) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StreamTopic.Descriptor instead.
+func (StreamTopic) EnumDescriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{1}
+}
+
+// STAFF NOTES
+type NoteSeverity int32
+
+const (
+	NoteSeverity_NOTE_INFO NoteSeverity = 0
+	// NOTE_WATCH - watch this player (ex. suspected x-ray)
+	NoteSeverity_NOTE_WATCH NoteSeverity = 1
+	// NOTE_ALERT - act on sight
+	NoteSeverity_NOTE_ALERT NoteSeverity = 2
+)
+
+// Enum value maps for NoteSeverity.
+var (
+	NoteSeverity_name = map[int32]string{
+		0: "NOTE_INFO",
+		1: "NOTE_WATCH",
+		2: "NOTE_ALERT",
+	}
+	NoteSeverity_value = map[string]int32{
+		"NOTE_INFO":  0,
+		"NOTE_WATCH": 1,
+		"NOTE_ALERT": 2,
+	}
+)
+
+func (x NoteSeverity) Enum() *NoteSeverity {
+	p := new(NoteSeverity)
+	*p = x
+	return p
+}
+
+func (x NoteSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NoteSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_systera_proto_enumTypes[2].Descriptor()
+}
+
+func (NoteSeverity) Type() protoreflect.EnumType {
+	return &file_systera_proto_enumTypes[2]
+}
+
+func (x NoteSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NoteSeverity.Descriptor instead.
+func (NoteSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{2}
+}
+
 // PLAYER PUNISHMENTS
 type PunishLevel int32
 
@@ -110,11 +220,11 @@ func (x PunishLevel) String() string {
 }
 
 func (PunishLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_systera_proto_enumTypes[1].Descriptor()
+	return file_systera_proto_enumTypes[3].Descriptor()
 }
 
 func (PunishLevel) Type() protoreflect.EnumType {
-	return &file_systera_proto_enumTypes[1]
+	return &file_systera_proto_enumTypes[3]
 }
 
 func (x PunishLevel) Number() protoreflect.EnumNumber {
@@ -123,7 +233,62 @@ func (x PunishLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PunishLevel.Descriptor instead.
 func (PunishLevel) EnumDescriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{1}
+	return file_systera_proto_rawDescGZIP(), []int{3}
+}
+
+// PUNISHMENT APPEALS
+type AppealState int32
+
+const (
+	// APPEAL_ANY - any state (ListAppealsRequest filter only)
+	AppealState_APPEAL_ANY  AppealState = 0
+	AppealState_APPEAL_OPEN AppealState = 1
+	// APPEAL_ACCEPTED - punishment is revoked
+	AppealState_APPEAL_ACCEPTED AppealState = 2
+	AppealState_APPEAL_DENIED   AppealState = 3
+)
+
+// Enum value maps for AppealState.
+var (
+	AppealState_name = map[int32]string{
+		0: "APPEAL_ANY",
+		1: "APPEAL_OPEN",
+		2: "APPEAL_ACCEPTED",
+		3: "APPEAL_DENIED",
+	}
+	AppealState_value = map[string]int32{
+		"APPEAL_ANY":      0,
+		"APPEAL_OPEN":     1,
+		"APPEAL_ACCEPTED": 2,
+		"APPEAL_DENIED":   3,
+	}
+)
+
+func (x AppealState) Enum() *AppealState {
+	p := new(AppealState)
+	*p = x
+	return p
+}
+
+func (x AppealState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppealState) Descriptor() protoreflect.EnumDescriptor {
+	return file_systera_proto_enumTypes[4].Descriptor()
+}
+
+func (AppealState) Type() protoreflect.EnumType {
+	return &file_systera_proto_enumTypes[4]
+}
+
+func (x AppealState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppealState.Descriptor instead.
+func (AppealState) EnumDescriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{4}
 }
 
 type SystemStream_Type int32
@@ -156,11 +321,11 @@ func (x SystemStream_Type) String() string {
 }
 
 func (SystemStream_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_systera_proto_enumTypes[2].Descriptor()
+	return file_systera_proto_enumTypes[5].Descriptor()
 }
 
 func (SystemStream_Type) Type() protoreflect.EnumType {
-	return &file_systera_proto_enumTypes[2]
+	return &file_systera_proto_enumTypes[5]
 }
 
 func (x SystemStream_Type) Number() protoreflect.EnumNumber {
@@ -169,7 +334,7 @@ func (x SystemStream_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SystemStream_Type.Descriptor instead.
 func (SystemStream_Type) EnumDescriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{8, 0}
+	return file_systera_proto_rawDescGZIP(), []int{39, 0}
 }
 
 type PlayerStream_Type int32
@@ -205,11 +370,11 @@ func (x PlayerStream_Type) String() string {
 }
 
 func (PlayerStream_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_systera_proto_enumTypes[3].Descriptor()
+	return file_systera_proto_enumTypes[6].Descriptor()
 }
 
 func (PlayerStream_Type) Type() protoreflect.EnumType {
-	return &file_systera_proto_enumTypes[3]
+	return &file_systera_proto_enumTypes[6]
 }
 
 func (x PlayerStream_Type) Number() protoreflect.EnumNumber {
@@ -218,7 +383,7 @@ func (x PlayerStream_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlayerStream_Type.Descriptor instead.
 func (PlayerStream_Type) EnumDescriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{9, 0}
+	return file_systera_proto_rawDescGZIP(), []int{40, 0}
 }
 
 type PunishmentStream_Type int32
@@ -226,6 +391,8 @@ type PunishmentStream_Type int32
 const (
 	PunishmentStream_PUNISH PunishmentStream_Type = 0
 	PunishmentStream_REPORT PunishmentStream_Type = 1
+	// APPEAL - appeal submitted / reviewed (appeal_entry.state)
+	PunishmentStream_APPEAL PunishmentStream_Type = 2
 )
 
 // Enum value maps for PunishmentStream_Type.
@@ -233,10 +400,12 @@ var (
 	PunishmentStream_Type_name = map[int32]string{
 		0: "PUNISH",
 		1: "REPORT",
+		2: "APPEAL",
 	}
 	PunishmentStream_Type_value = map[string]int32{
 		"PUNISH": 0,
 		"REPORT": 1,
+		"APPEAL": 2,
 	}
 )
 
@@ -251,11 +420,11 @@ func (x PunishmentStream_Type) String() string {
 }
 
 func (PunishmentStream_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_systera_proto_enumTypes[4].Descriptor()
+	return file_systera_proto_enumTypes[7].Descriptor()
 }
 
 func (PunishmentStream_Type) Type() protoreflect.EnumType {
-	return &file_systera_proto_enumTypes[4]
+	return &file_systera_proto_enumTypes[7]
 }
 
 func (x PunishmentStream_Type) Number() protoreflect.EnumNumber {
@@ -264,7 +433,7 @@ func (x PunishmentStream_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PunishmentStream_Type.Descriptor instead.
 func (PunishmentStream_Type) EnumDescriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{10, 0}
+	return file_systera_proto_rawDescGZIP(), []int{41, 0}
 }
 
 type GroupStream_Type int32
@@ -297,11 +466,11 @@ func (x GroupStream_Type) String() string {
 }
 
 func (GroupStream_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_systera_proto_enumTypes[5].Descriptor()
+	return file_systera_proto_enumTypes[8].Descriptor()
 }
 
 func (GroupStream_Type) Type() protoreflect.EnumType {
-	return &file_systera_proto_enumTypes[5]
+	return &file_systera_proto_enumTypes[8]
 }
 
 func (x GroupStream_Type) Number() protoreflect.EnumNumber {
@@ -310,7 +479,53 @@ func (x GroupStream_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GroupStream_Type.Descriptor instead.
 func (GroupStream_Type) EnumDescriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{12, 0}
+	return file_systera_proto_rawDescGZIP(), []int{43, 0}
+}
+
+type ServerStream_Type int32
+
+const (
+	ServerStream_UP   ServerStream_Type = 0
+	ServerStream_DOWN ServerStream_Type = 1
+)
+
+// Enum value maps for ServerStream_Type.
+var (
+	ServerStream_Type_name = map[int32]string{
+		0: "UP",
+		1: "DOWN",
+	}
+	ServerStream_Type_value = map[string]int32{
+		"UP":   0,
+		"DOWN": 1,
+	}
+)
+
+func (x ServerStream_Type) Enum() *ServerStream_Type {
+	p := new(ServerStream_Type)
+	*p = x
+	return p
+}
+
+func (x ServerStream_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServerStream_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_systera_proto_enumTypes[9].Descriptor()
+}
+
+func (ServerStream_Type) Type() protoreflect.EnumType {
+	return &file_systera_proto_enumTypes[9]
+}
+
+func (x ServerStream_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServerStream_Type.Descriptor instead.
+func (ServerStream_Type) EnumDescriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{44, 0}
 }
 
 type ChatStream_Type int32
@@ -340,11 +555,11 @@ func (x ChatStream_Type) String() string {
 }
 
 func (ChatStream_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_systera_proto_enumTypes[6].Descriptor()
+	return file_systera_proto_enumTypes[10].Descriptor()
 }
 
 func (ChatStream_Type) Type() protoreflect.EnumType {
-	return &file_systera_proto_enumTypes[6]
+	return &file_systera_proto_enumTypes[10]
 }
 
 func (x ChatStream_Type) Number() protoreflect.EnumNumber {
@@ -353,7 +568,7 @@ func (x ChatStream_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ChatStream_Type.Descriptor instead.
 func (ChatStream_Type) EnumDescriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{13, 0}
+	return file_systera_proto_rawDescGZIP(), []int{45, 0}
 }
 
 type Empty struct {
@@ -400,6 +615,7 @@ type AnnounceRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// target - "global", server group, glob pattern (ex. "minigame-*") or server
 	Target  string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
@@ -450,17 +666,17 @@ func (x *AnnounceRequest) GetMessage() string {
 	return ""
 }
 
-type DispatchRequest struct {
+type AnnounceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	Cmd    string `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	// targets - servers announced to
+	Targets []string `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
 }
 
-func (x *DispatchRequest) Reset() {
-	*x = DispatchRequest{}
+func (x *AnnounceResponse) Reset() {
+	*x = AnnounceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -468,13 +684,13 @@ func (x *DispatchRequest) Reset() {
 	}
 }
 
-func (x *DispatchRequest) String() string {
+func (x *AnnounceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DispatchRequest) ProtoMessage() {}
+func (*AnnounceResponse) ProtoMessage() {}
 
-func (x *DispatchRequest) ProtoReflect() protoreflect.Message {
+func (x *AnnounceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -486,38 +702,34 @@ func (x *DispatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DispatchRequest.ProtoReflect.Descriptor instead.
-func (*DispatchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AnnounceResponse.ProtoReflect.Descriptor instead.
+func (*AnnounceResponse) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *DispatchRequest) GetTarget() string {
-	if x != nil {
-		return x.Target
-	}
-	return ""
-}
-
-func (x *DispatchRequest) GetCmd() string {
+func (x *AnnounceResponse) GetTargets() []string {
 	if x != nil {
-		return x.Cmd
+		return x.Targets
 	}
-	return ""
+	return nil
 }
 
-type ChatEntry struct {
+type DispatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// author - name with diplayName
-	Author     *PlayerIdentity `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
-	ServerName string          `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
-	Message    string          `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// target - same as AnnounceRequest.target
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Cmd    string `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	// wait_timeout - wait for results up to this duration (millis, 0: no wait, max: 30000)
+	WaitTimeout int64 `protobuf:"varint,3,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
+	// from - issuer (audit trail)
+	From *PlayerIdentity `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
 }
 
-func (x *ChatEntry) Reset() {
-	*x = ChatEntry{}
+func (x *DispatchRequest) Reset() {
+	*x = DispatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -525,13 +737,13 @@ func (x *ChatEntry) Reset() {
 	}
 }
 
-func (x *ChatEntry) String() string {
+func (x *DispatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatEntry) ProtoMessage() {}
+func (*DispatchRequest) ProtoMessage() {}
 
-func (x *ChatEntry) ProtoReflect() protoreflect.Message {
+func (x *DispatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,42 +755,52 @@ func (x *ChatEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatEntry.ProtoReflect.Descriptor instead.
-func (*ChatEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use DispatchRequest.ProtoReflect.Descriptor instead.
+func (*DispatchRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ChatEntry) GetAuthor() *PlayerIdentity {
+func (x *DispatchRequest) GetTarget() string {
 	if x != nil {
-		return x.Author
+		return x.Target
 	}
-	return nil
+	return ""
 }
 
-func (x *ChatEntry) GetServerName() string {
+func (x *DispatchRequest) GetCmd() string {
 	if x != nil {
-		return x.ServerName
+		return x.Cmd
 	}
 	return ""
 }
 
-func (x *ChatEntry) GetMessage() string {
+func (x *DispatchRequest) GetWaitTimeout() int64 {
 	if x != nil {
-		return x.Message
+		return x.WaitTimeout
 	}
-	return ""
+	return 0
 }
 
-type ChatRequest struct {
+func (x *DispatchRequest) GetFrom() *PlayerIdentity {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+type DispatchResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entry *ChatEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	ServerName string `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Success    bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Output     string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	Date       int64  `protobuf:"varint,4,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *ChatRequest) Reset() {
-	*x = ChatRequest{}
+func (x *DispatchResult) Reset() {
+	*x = DispatchResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -586,13 +808,13 @@ func (x *ChatRequest) Reset() {
 	}
 }
 
-func (x *ChatRequest) String() string {
+func (x *DispatchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatRequest) ProtoMessage() {}
+func (*DispatchResult) ProtoMessage() {}
 
-func (x *ChatRequest) ProtoReflect() protoreflect.Message {
+func (x *DispatchResult) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,29 +826,54 @@ func (x *ChatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatRequest.ProtoReflect.Descriptor instead.
-func (*ChatRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DispatchResult.ProtoReflect.Descriptor instead.
+func (*DispatchResult) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ChatRequest) GetEntry() *ChatEntry {
+func (x *DispatchResult) GetServerName() string {
 	if x != nil {
-		return x.Entry
+		return x.ServerName
 	}
-	return nil
+	return ""
 }
 
-type AddChatIgnoreRequest struct {
+func (x *DispatchResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DispatchResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *DispatchResult) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+type DispatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid   string          `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Target *PlayerIdentity `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// id - correlation ID (SystemStream.id)
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// results - results reported until wait_timeout
+	Results []*DispatchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	// targets - servers dispatched to
+	Targets []string `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
 }
 
-func (x *AddChatIgnoreRequest) Reset() {
-	*x = AddChatIgnoreRequest{}
+func (x *DispatchResponse) Reset() {
+	*x = DispatchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -634,13 +881,13 @@ func (x *AddChatIgnoreRequest) Reset() {
 	}
 }
 
-func (x *AddChatIgnoreRequest) String() string {
+func (x *DispatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddChatIgnoreRequest) ProtoMessage() {}
+func (*DispatchResponse) ProtoMessage() {}
 
-func (x *AddChatIgnoreRequest) ProtoReflect() protoreflect.Message {
+func (x *DispatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -652,36 +899,46 @@ func (x *AddChatIgnoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddChatIgnoreRequest.ProtoReflect.Descriptor instead.
-func (*AddChatIgnoreRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DispatchResponse.ProtoReflect.Descriptor instead.
+func (*DispatchResponse) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AddChatIgnoreRequest) GetUuid() string {
+func (x *DispatchResponse) GetId() string {
 	if x != nil {
-		return x.Uuid
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AddChatIgnoreRequest) GetTarget() *PlayerIdentity {
+func (x *DispatchResponse) GetResults() []*DispatchResult {
 	if x != nil {
-		return x.Target
+		return x.Results
 	}
 	return nil
 }
 
-type RemoveChatIgnoreRequest struct {
+func (x *DispatchResponse) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type ReportDispatchResultRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid   string          `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Target *PlayerIdentity `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// id - SystemStream.id of dispatched command
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServerName string `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Success    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Output     string `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *RemoveChatIgnoreRequest) Reset() {
-	*x = RemoveChatIgnoreRequest{}
+func (x *ReportDispatchResultRequest) Reset() {
+	*x = ReportDispatchResultRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -689,13 +946,13 @@ func (x *RemoveChatIgnoreRequest) Reset() {
 	}
 }
 
-func (x *RemoveChatIgnoreRequest) String() string {
+func (x *ReportDispatchResultRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveChatIgnoreRequest) ProtoMessage() {}
+func (*ReportDispatchResultRequest) ProtoMessage() {}
 
-func (x *RemoveChatIgnoreRequest) ProtoReflect() protoreflect.Message {
+func (x *ReportDispatchResultRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,36 +964,54 @@ func (x *RemoveChatIgnoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveChatIgnoreRequest.ProtoReflect.Descriptor instead.
-func (*RemoveChatIgnoreRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReportDispatchResultRequest.ProtoReflect.Descriptor instead.
+func (*ReportDispatchResultRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RemoveChatIgnoreRequest) GetUuid() string {
+func (x *ReportDispatchResultRequest) GetId() string {
 	if x != nil {
-		return x.Uuid
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RemoveChatIgnoreRequest) GetTarget() *PlayerIdentity {
+func (x *ReportDispatchResultRequest) GetServerName() string {
 	if x != nil {
-		return x.Target
+		return x.ServerName
 	}
-	return nil
+	return ""
 }
 
-type ChatIgnoreResponse struct {
+func (x *ReportDispatchResultRequest) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReportDispatchResultRequest) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type DispatchEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result   CallResult      `protobuf:"varint,1,opt,name=result,proto3,enum=systerapb.CallResult" json:"result,omitempty"`
-	Identity *PlayerIdentity `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Id      string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Target  string            `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Cmd     string            `protobuf:"bytes,3,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	From    *PlayerIdentity   `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	Date    int64             `protobuf:"varint,5,opt,name=date,proto3" json:"date,omitempty"`
+	Results []*DispatchResult `protobuf:"bytes,6,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *ChatIgnoreResponse) Reset() {
-	*x = ChatIgnoreResponse{}
+func (x *DispatchEntry) Reset() {
+	*x = DispatchEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -744,13 +1019,13 @@ func (x *ChatIgnoreResponse) Reset() {
 	}
 }
 
-func (x *ChatIgnoreResponse) String() string {
+func (x *DispatchEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatIgnoreResponse) ProtoMessage() {}
+func (*DispatchEntry) ProtoMessage() {}
 
-func (x *ChatIgnoreResponse) ProtoReflect() protoreflect.Message {
+func (x *DispatchEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -762,37 +1037,66 @@ func (x *ChatIgnoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatIgnoreResponse.ProtoReflect.Descriptor instead.
-func (*ChatIgnoreResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DispatchEntry.ProtoReflect.Descriptor instead.
+func (*DispatchEntry) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ChatIgnoreResponse) GetResult() CallResult {
+func (x *DispatchEntry) GetId() string {
 	if x != nil {
-		return x.Result
+		return x.Id
 	}
-	return CallResult_SUCCESS
+	return ""
 }
 
-func (x *ChatIgnoreResponse) GetIdentity() *PlayerIdentity {
+func (x *DispatchEntry) GetTarget() string {
 	if x != nil {
-		return x.Identity
+		return x.Target
+	}
+	return ""
+}
+
+func (x *DispatchEntry) GetCmd() string {
+	if x != nil {
+		return x.Cmd
+	}
+	return ""
+}
+
+func (x *DispatchEntry) GetFrom() *PlayerIdentity {
+	if x != nil {
+		return x.From
 	}
 	return nil
 }
 
-// System
-type SystemStream struct {
+func (x *DispatchEntry) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *DispatchEntry) GetResults() []*DispatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetDispatchHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type SystemStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.SystemStream_Type" json:"type,omitempty"`
-	Msg  string            `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// target - filter by target (empty: all)
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// limit - max entries, newest first (0: 50, max 500)
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *SystemStream) Reset() {
-	*x = SystemStream{}
+func (x *GetDispatchHistoryRequest) Reset() {
+	*x = GetDispatchHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -800,13 +1104,13 @@ func (x *SystemStream) Reset() {
 	}
 }
 
-func (x *SystemStream) String() string {
+func (x *GetDispatchHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemStream) ProtoMessage() {}
+func (*GetDispatchHistoryRequest) ProtoMessage() {}
 
-func (x *SystemStream) ProtoReflect() protoreflect.Message {
+func (x *GetDispatchHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -818,37 +1122,35 @@ func (x *SystemStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemStream.ProtoReflect.Descriptor instead.
-func (*SystemStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDispatchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDispatchHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SystemStream) GetType() SystemStream_Type {
+func (x *GetDispatchHistoryRequest) GetTarget() string {
 	if x != nil {
-		return x.Type
+		return x.Target
 	}
-	return SystemStream_DISPATCH
+	return ""
 }
 
-func (x *SystemStream) GetMsg() string {
+func (x *GetDispatchHistoryRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Msg
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-// Player
-type PlayerStream struct {
+type GetDispatchHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  PlayerStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.PlayerStream_Type" json:"type,omitempty"`
-	Entry *PlayerEntry      `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	Entries []*DispatchEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *PlayerStream) Reset() {
-	*x = PlayerStream{}
+func (x *GetDispatchHistoryResponse) Reset() {
+	*x = GetDispatchHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,13 +1158,13 @@ func (x *PlayerStream) Reset() {
 	}
 }
 
-func (x *PlayerStream) String() string {
+func (x *GetDispatchHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerStream) ProtoMessage() {}
+func (*GetDispatchHistoryResponse) ProtoMessage() {}
 
-func (x *PlayerStream) ProtoReflect() protoreflect.Message {
+func (x *GetDispatchHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -874,38 +1176,30 @@ func (x *PlayerStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerStream.ProtoReflect.Descriptor instead.
-func (*PlayerStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDispatchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDispatchHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PlayerStream) GetType() PlayerStream_Type {
-	if x != nil {
-		return x.Type
-	}
-	return PlayerStream_ALL
-}
-
-func (x *PlayerStream) GetEntry() *PlayerEntry {
+func (x *GetDispatchHistoryResponse) GetEntries() []*DispatchEntry {
 	if x != nil {
-		return x.Entry
+		return x.Entries
 	}
 	return nil
 }
 
-// Punishment
-type PunishmentStream struct {
+type ServerGroupEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type              PunishmentStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.PunishmentStream_Type" json:"type,omitempty"`
-	PunishStreamEntry *PunishStreamEntry    `protobuf:"bytes,2,opt,name=punish_stream_entry,json=punishStreamEntry,proto3" json:"punish_stream_entry,omitempty"`
-	ReportEntry       *ReportEntry          `protobuf:"bytes,3,opt,name=report_entry,json=reportEntry,proto3" json:"report_entry,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// servers - server names or glob patterns
+	Servers []string `protobuf:"bytes,2,rep,name=servers,proto3" json:"servers,omitempty"`
 }
 
-func (x *PunishmentStream) Reset() {
-	*x = PunishmentStream{}
+func (x *ServerGroupEntry) Reset() {
+	*x = ServerGroupEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -913,13 +1207,13 @@ func (x *PunishmentStream) Reset() {
 	}
 }
 
-func (x *PunishmentStream) String() string {
+func (x *ServerGroupEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PunishmentStream) ProtoMessage() {}
+func (*ServerGroupEntry) ProtoMessage() {}
 
-func (x *PunishmentStream) ProtoReflect() protoreflect.Message {
+func (x *ServerGroupEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -931,43 +1225,33 @@ func (x *PunishmentStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PunishmentStream.ProtoReflect.Descriptor instead.
-func (*PunishmentStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerGroupEntry.ProtoReflect.Descriptor instead.
+func (*ServerGroupEntry) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PunishmentStream) GetType() PunishmentStream_Type {
-	if x != nil {
-		return x.Type
-	}
-	return PunishmentStream_PUNISH
-}
-
-func (x *PunishmentStream) GetPunishStreamEntry() *PunishStreamEntry {
+func (x *ServerGroupEntry) GetName() string {
 	if x != nil {
-		return x.PunishStreamEntry
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *PunishmentStream) GetReportEntry() *ReportEntry {
+func (x *ServerGroupEntry) GetServers() []string {
 	if x != nil {
-		return x.ReportEntry
+		return x.Servers
 	}
 	return nil
 }
 
-type PunishStreamEntry struct {
+type FetchServerGroupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Entry          *PunishEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
-	RequireExecute bool         `protobuf:"varint,2,opt,name=require_execute,json=requireExecute,proto3" json:"require_execute,omitempty"`
 }
 
-func (x *PunishStreamEntry) Reset() {
-	*x = PunishStreamEntry{}
+func (x *FetchServerGroupsRequest) Reset() {
+	*x = FetchServerGroupsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -975,13 +1259,13 @@ func (x *PunishStreamEntry) Reset() {
 	}
 }
 
-func (x *PunishStreamEntry) String() string {
+func (x *FetchServerGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PunishStreamEntry) ProtoMessage() {}
+func (*FetchServerGroupsRequest) ProtoMessage() {}
 
-func (x *PunishStreamEntry) ProtoReflect() protoreflect.Message {
+func (x *FetchServerGroupsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -993,37 +1277,21 @@ func (x *PunishStreamEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PunishStreamEntry.ProtoReflect.Descriptor instead.
-func (*PunishStreamEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use FetchServerGroupsRequest.ProtoReflect.Descriptor instead.
+func (*FetchServerGroupsRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *PunishStreamEntry) GetEntry() *PunishEntry {
-	if x != nil {
-		return x.Entry
-	}
-	return nil
-}
-
-func (x *PunishStreamEntry) GetRequireExecute() bool {
-	if x != nil {
-		return x.RequireExecute
-	}
-	return false
-}
-
-// Group
-type GroupStream struct {
+type FetchServerGroupsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type       GroupStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.GroupStream_Type" json:"type,omitempty"`
-	GroupEntry *GroupEntry      `protobuf:"bytes,2,opt,name=group_entry,json=groupEntry,proto3" json:"group_entry,omitempty"`
+	Groups []*ServerGroupEntry `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *GroupStream) Reset() {
-	*x = GroupStream{}
+func (x *FetchServerGroupsResponse) Reset() {
+	*x = FetchServerGroupsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1031,13 +1299,13 @@ func (x *GroupStream) Reset() {
 	}
 }
 
-func (x *GroupStream) String() string {
+func (x *FetchServerGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupStream) ProtoMessage() {}
+func (*FetchServerGroupsResponse) ProtoMessage() {}
 
-func (x *GroupStream) ProtoReflect() protoreflect.Message {
+func (x *FetchServerGroupsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1049,37 +1317,29 @@ func (x *GroupStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupStream.ProtoReflect.Descriptor instead.
-func (*GroupStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use FetchServerGroupsResponse.ProtoReflect.Descriptor instead.
+func (*FetchServerGroupsResponse) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GroupStream) GetType() GroupStream_Type {
-	if x != nil {
-		return x.Type
-	}
-	return GroupStream_GROUP
-}
-
-func (x *GroupStream) GetGroupEntry() *GroupEntry {
+func (x *FetchServerGroupsResponse) GetGroups() []*ServerGroupEntry {
 	if x != nil {
-		return x.GroupEntry
+		return x.Groups
 	}
 	return nil
 }
 
-// TextMessage
-type ChatStream struct {
+// SetServerGroup - create or replace group
+type SetServerGroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type      ChatStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.ChatStream_Type" json:"type,omitempty"`
-	ChatEntry *ChatEntry      `protobuf:"bytes,2,opt,name=chat_entry,json=chatEntry,proto3" json:"chat_entry,omitempty"`
+	Group *ServerGroupEntry `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 }
 
-func (x *ChatStream) Reset() {
-	*x = ChatStream{}
+func (x *SetServerGroupRequest) Reset() {
+	*x = SetServerGroupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1087,13 +1347,13 @@ func (x *ChatStream) Reset() {
 	}
 }
 
-func (x *ChatStream) String() string {
+func (x *SetServerGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatStream) ProtoMessage() {}
+func (*SetServerGroupRequest) ProtoMessage() {}
 
-func (x *ChatStream) ProtoReflect() protoreflect.Message {
+func (x *SetServerGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1105,37 +1365,28 @@ func (x *ChatStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatStream.ProtoReflect.Descriptor instead.
-func (*ChatStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetServerGroupRequest.ProtoReflect.Descriptor instead.
+func (*SetServerGroupRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ChatStream) GetType() ChatStream_Type {
-	if x != nil {
-		return x.Type
-	}
-	return ChatStream_CHAT
-}
-
-func (x *ChatStream) GetChatEntry() *ChatEntry {
+func (x *SetServerGroupRequest) GetGroup() *ServerGroupEntry {
 	if x != nil {
-		return x.ChatEntry
+		return x.Group
 	}
 	return nil
 }
 
-// PLAYER PROFILE
-type PlayerIdentity struct {
+type RemoveServerGroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *PlayerIdentity) Reset() {
-	*x = PlayerIdentity{}
+func (x *RemoveServerGroupRequest) Reset() {
+	*x = RemoveServerGroupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1143,13 +1394,13 @@ func (x *PlayerIdentity) Reset() {
 	}
 }
 
-func (x *PlayerIdentity) String() string {
+func (x *RemoveServerGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerIdentity) ProtoMessage() {}
+func (*RemoveServerGroupRequest) ProtoMessage() {}
 
-func (x *PlayerIdentity) ProtoReflect() protoreflect.Message {
+func (x *RemoveServerGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1161,35 +1412,39 @@ func (x *PlayerIdentity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerIdentity.ProtoReflect.Descriptor instead.
-func (*PlayerIdentity) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveServerGroupRequest.ProtoReflect.Descriptor instead.
+func (*RemoveServerGroupRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PlayerIdentity) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *PlayerIdentity) GetName() string {
+func (x *RemoveServerGroupRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type GetPlayerIdentityByNameRequest struct {
+type ServerEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *GetPlayerIdentityByNameRequest) Reset() {
-	*x = GetPlayerIdentityByNameRequest{}
+	// address - host:port reachable from proxies
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Players    int32  `protobuf:"varint,3,opt,name=players,proto3" json:"players,omitempty"`
+	MaxPlayers int32  `protobuf:"varint,4,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	Motd       string `protobuf:"bytes,5,opt,name=motd,proto3" json:"motd,omitempty"`
+	// state - server defined state (ex. "WAITING", "INGAME")
+	State string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	// online - heartbeat not expired (set by API)
+	Online bool `protobuf:"varint,7,opt,name=online,proto3" json:"online,omitempty"`
+	// last_heartbeat - millis (set by API)
+	LastHeartbeat int64 `protobuf:"varint,8,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+}
+
+func (x *ServerEntry) Reset() {
+	*x = ServerEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1197,13 +1452,13 @@ func (x *GetPlayerIdentityByNameRequest) Reset() {
 	}
 }
 
-func (x *GetPlayerIdentityByNameRequest) String() string {
+func (x *ServerEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlayerIdentityByNameRequest) ProtoMessage() {}
+func (*ServerEntry) ProtoMessage() {}
 
-func (x *GetPlayerIdentityByNameRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1215,101 +1470,95 @@ func (x *GetPlayerIdentityByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlayerIdentityByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetPlayerIdentityByNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerEntry.ProtoReflect.Descriptor instead.
+func (*ServerEntry) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetPlayerIdentityByNameRequest) GetName() string {
+func (x *ServerEntry) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type GetPlayerIdentityByNameResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Identity *PlayerIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
-	Exists   bool            `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+func (x *ServerEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
-func (x *GetPlayerIdentityByNameResponse) Reset() {
-	*x = GetPlayerIdentityByNameResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_systera_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ServerEntry) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
 	}
+	return 0
 }
 
-func (x *GetPlayerIdentityByNameResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ServerEntry) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
 }
 
-func (*GetPlayerIdentityByNameResponse) ProtoMessage() {}
-
-func (x *GetPlayerIdentityByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systera_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ServerEntry) GetMotd() string {
+	if x != nil {
+		return x.Motd
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetPlayerIdentityByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetPlayerIdentityByNameResponse) Descriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{16}
+func (x *ServerEntry) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-func (x *GetPlayerIdentityByNameResponse) GetIdentity() *PlayerIdentity {
+func (x *ServerEntry) GetOnline() bool {
 	if x != nil {
-		return x.Identity
+		return x.Online
 	}
-	return nil
+	return false
 }
 
-func (x *GetPlayerIdentityByNameResponse) GetExists() bool {
+func (x *ServerEntry) GetLastHeartbeat() int64 {
 	if x != nil {
-		return x.Exists
+		return x.LastHeartbeat
 	}
-	return false
+	return 0
 }
 
-type PlayerSettings struct {
+// ServerHeartbeat - register (first call) / renew server
+type ServerHeartbeatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JoinMessage bool `protobuf:"varint,1,opt,name=join_message,json=joinMessage,proto3" json:"join_message,omitempty"`
-	Vanish      bool `protobuf:"varint,2,opt,name=vanish,proto3" json:"vanish,omitempty"`
-	Japanize    bool `protobuf:"varint,3,opt,name=japanize,proto3" json:"japanize,omitempty"`
-	GlobalChat  bool `protobuf:"varint,4,opt,name=global_chat,json=globalChat,proto3" json:"global_chat,omitempty"`
+	Entry *ServerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// ttl - expire after this duration without heartbeat (millis, 0: default)
+	Ttl int64 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *PlayerSettings) Reset() {
-	*x = PlayerSettings{}
+func (x *ServerHeartbeatRequest) Reset() {
+	*x = ServerHeartbeatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_systera_proto_msgTypes[17]
+		mi := &file_systera_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlayerSettings) String() string {
+func (x *ServerHeartbeatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerSettings) ProtoMessage() {}
+func (*ServerHeartbeatRequest) ProtoMessage() {}
 
-func (x *PlayerSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_systera_proto_msgTypes[17]
+func (x *ServerHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,71 +1569,51 @@ func (x *PlayerSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerSettings.ProtoReflect.Descriptor instead.
-func (*PlayerSettings) Descriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *PlayerSettings) GetJoinMessage() bool {
-	if x != nil {
-		return x.JoinMessage
-	}
-	return false
-}
-
-func (x *PlayerSettings) GetVanish() bool {
-	if x != nil {
-		return x.Vanish
-	}
-	return false
+// Deprecated: Use ServerHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*ServerHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PlayerSettings) GetJapanize() bool {
+func (x *ServerHeartbeatRequest) GetEntry() *ServerEntry {
 	if x != nil {
-		return x.Japanize
+		return x.Entry
 	}
-	return false
+	return nil
 }
 
-func (x *PlayerSettings) GetGlobalChat() bool {
+func (x *ServerHeartbeatRequest) GetTtl() int64 {
 	if x != nil {
-		return x.GlobalChat
+		return x.Ttl
 	}
-	return false
+	return 0
 }
 
-type PlayerEntry struct {
+// UnregisterServer - mark server down (graceful shutdown)
+type UnregisterServerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid          string            `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name          string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CurrentServer string            `protobuf:"bytes,3,opt,name=current_server,json=currentServer,proto3" json:"current_server,omitempty"`
-	FirstLogin    int64             `protobuf:"varint,4,opt,name=first_login,json=firstLogin,proto3" json:"first_login,omitempty"`
-	LastLogin     int64             `protobuf:"varint,5,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
-	Groups        []string          `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
-	Settings      *PlayerSettings   `protobuf:"bytes,7,opt,name=settings,proto3" json:"settings,omitempty"`
-	PlayerIgnore  []*PlayerIdentity `protobuf:"bytes,8,rep,name=player_ignore,json=playerIgnore,proto3" json:"player_ignore,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *PlayerEntry) Reset() {
-	*x = PlayerEntry{}
+func (x *UnregisterServerRequest) Reset() {
+	*x = UnregisterServerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_systera_proto_msgTypes[18]
+		mi := &file_systera_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PlayerEntry) String() string {
+func (x *UnregisterServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerEntry) ProtoMessage() {}
+func (*UnregisterServerRequest) ProtoMessage() {}
 
-func (x *PlayerEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_systera_proto_msgTypes[18]
+func (x *UnregisterServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,80 +1624,76 @@ func (x *PlayerEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerEntry.ProtoReflect.Descriptor instead.
-func (*PlayerEntry) Descriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *PlayerEntry) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
+// Deprecated: Use UnregisterServerRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterServerRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PlayerEntry) GetName() string {
+func (x *UnregisterServerRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *PlayerEntry) GetCurrentServer() string {
-	if x != nil {
-		return x.CurrentServer
-	}
-	return ""
+type ListServersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// include_offline - include expired / unregistered servers
+	IncludeOffline bool `protobuf:"varint,1,opt,name=include_offline,json=includeOffline,proto3" json:"include_offline,omitempty"`
 }
 
-func (x *PlayerEntry) GetFirstLogin() int64 {
-	if x != nil {
-		return x.FirstLogin
+func (x *ListServersRequest) Reset() {
+	*x = ListServersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *PlayerEntry) GetLastLogin() int64 {
-	if x != nil {
-		return x.LastLogin
-	}
-	return 0
+func (x *ListServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PlayerEntry) GetGroups() []string {
-	if x != nil {
-		return x.Groups
+func (*ListServersRequest) ProtoMessage() {}
+
+func (x *ListServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PlayerEntry) GetSettings() *PlayerSettings {
-	if x != nil {
-		return x.Settings
-	}
-	return nil
+// Deprecated: Use ListServersRequest.ProtoReflect.Descriptor instead.
+func (*ListServersRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PlayerEntry) GetPlayerIgnore() []*PlayerIdentity {
+func (x *ListServersRequest) GetIncludeOffline() bool {
 	if x != nil {
-		return x.PlayerIgnore
+		return x.IncludeOffline
 	}
-	return nil
+	return false
 }
 
-type InitPlayerProfileRequest struct {
+type ListServersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid      string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IpAddress string `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	Hostname  string `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Entries []*ServerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *InitPlayerProfileRequest) Reset() {
-	*x = InitPlayerProfileRequest{}
+func (x *ListServersResponse) Reset() {
+	*x = ListServersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1476,13 +1701,13 @@ func (x *InitPlayerProfileRequest) Reset() {
 	}
 }
 
-func (x *InitPlayerProfileRequest) String() string {
+func (x *ListServersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitPlayerProfileRequest) ProtoMessage() {}
+func (*ListServersResponse) ProtoMessage() {}
 
-func (x *InitPlayerProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *ListServersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1494,49 +1719,28 @@ func (x *InitPlayerProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitPlayerProfileRequest.ProtoReflect.Descriptor instead.
-func (*InitPlayerProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListServersResponse.ProtoReflect.Descriptor instead.
+func (*ListServersResponse) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *InitPlayerProfileRequest) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *InitPlayerProfileRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *InitPlayerProfileRequest) GetIpAddress() string {
-	if x != nil {
-		return x.IpAddress
-	}
-	return ""
-}
-
-func (x *InitPlayerProfileRequest) GetHostname() string {
+func (x *ListServersResponse) GetEntries() []*ServerEntry {
 	if x != nil {
-		return x.Hostname
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type InitPlayerProfileResponse struct {
+type GetServerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entry *PlayerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *InitPlayerProfileResponse) Reset() {
-	*x = InitPlayerProfileResponse{}
+func (x *GetServerRequest) Reset() {
+	*x = GetServerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1544,13 +1748,13 @@ func (x *InitPlayerProfileResponse) Reset() {
 	}
 }
 
-func (x *InitPlayerProfileResponse) String() string {
+func (x *GetServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitPlayerProfileResponse) ProtoMessage() {}
+func (*GetServerRequest) ProtoMessage() {}
 
-func (x *InitPlayerProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *GetServerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1562,28 +1766,28 @@ func (x *InitPlayerProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitPlayerProfileResponse.ProtoReflect.Descriptor instead.
-func (*InitPlayerProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetServerRequest.ProtoReflect.Descriptor instead.
+func (*GetServerRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *InitPlayerProfileResponse) GetEntry() *PlayerEntry {
+func (x *GetServerRequest) GetName() string {
 	if x != nil {
-		return x.Entry
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type FetchPlayerProfileRequest struct {
+type GetServerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Entry *ServerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *FetchPlayerProfileRequest) Reset() {
-	*x = FetchPlayerProfileRequest{}
+func (x *GetServerResponse) Reset() {
+	*x = GetServerResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1591,13 +1795,13 @@ func (x *FetchPlayerProfileRequest) Reset() {
 	}
 }
 
-func (x *FetchPlayerProfileRequest) String() string {
+func (x *GetServerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchPlayerProfileRequest) ProtoMessage() {}
+func (*GetServerResponse) ProtoMessage() {}
 
-func (x *FetchPlayerProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *GetServerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1609,28 +1813,42 @@ func (x *FetchPlayerProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchPlayerProfileRequest.ProtoReflect.Descriptor instead.
-func (*FetchPlayerProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetServerResponse.ProtoReflect.Descriptor instead.
+func (*GetServerResponse) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *FetchPlayerProfileRequest) GetUuid() string {
+func (x *GetServerResponse) GetEntry() *ServerEntry {
 	if x != nil {
-		return x.Uuid
+		return x.Entry
 	}
-	return ""
+	return nil
 }
 
-type FetchPlayerProfileByNameRequest struct {
+type ScheduledAnnouncement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *FetchPlayerProfileByNameRequest) Reset() {
-	*x = FetchPlayerProfileByNameRequest{}
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// target - same as AnnounceRequest.target
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// messages - message pool (rotated on each run)
+	Messages []string `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	// cron - recurrence (ex. "*/5 * * * *", "@every 10m", empty: one-shot)
+	Cron string `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`
+	// at - one-shot run time / first run of recurrence (millis, 0: now)
+	At int64 `protobuf:"varint,5,opt,name=at,proto3" json:"at,omitempty"`
+	// next_run - next run (millis, 0: finished)
+	NextRun int64 `protobuf:"varint,6,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	// last_run - last run (millis, 0: never)
+	LastRun   int64           `protobuf:"varint,7,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	Author    *PlayerIdentity `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt int64           `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ScheduledAnnouncement) Reset() {
+	*x = ScheduledAnnouncement{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1638,13 +1856,13 @@ func (x *FetchPlayerProfileByNameRequest) Reset() {
 	}
 }
 
-func (x *FetchPlayerProfileByNameRequest) String() string {
+func (x *ScheduledAnnouncement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchPlayerProfileByNameRequest) ProtoMessage() {}
+func (*ScheduledAnnouncement) ProtoMessage() {}
 
-func (x *FetchPlayerProfileByNameRequest) ProtoReflect() protoreflect.Message {
+func (x *ScheduledAnnouncement) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1656,91 +1874,99 @@ func (x *FetchPlayerProfileByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchPlayerProfileByNameRequest.ProtoReflect.Descriptor instead.
-func (*FetchPlayerProfileByNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduledAnnouncement.ProtoReflect.Descriptor instead.
+func (*ScheduledAnnouncement) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FetchPlayerProfileByNameRequest) GetName() string {
+func (x *ScheduledAnnouncement) GetId() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type FetchPlayerProfileResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Entry *PlayerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+func (x *ScheduledAnnouncement) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
 }
 
-func (x *FetchPlayerProfileResponse) Reset() {
-	*x = FetchPlayerProfileResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_systera_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ScheduledAnnouncement) GetMessages() []string {
+	if x != nil {
+		return x.Messages
 	}
+	return nil
 }
 
-func (x *FetchPlayerProfileResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScheduledAnnouncement) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
 }
 
-func (*FetchPlayerProfileResponse) ProtoMessage() {}
-
-func (x *FetchPlayerProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systera_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ScheduledAnnouncement) GetAt() int64 {
+	if x != nil {
+		return x.At
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use FetchPlayerProfileResponse.ProtoReflect.Descriptor instead.
-func (*FetchPlayerProfileResponse) Descriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{23}
+func (x *ScheduledAnnouncement) GetNextRun() int64 {
+	if x != nil {
+		return x.NextRun
+	}
+	return 0
 }
 
-func (x *FetchPlayerProfileResponse) GetEntry() *PlayerEntry {
+func (x *ScheduledAnnouncement) GetLastRun() int64 {
 	if x != nil {
-		return x.Entry
+		return x.LastRun
+	}
+	return 0
+}
+
+func (x *ScheduledAnnouncement) GetAuthor() *PlayerIdentity {
+	if x != nil {
+		return x.Author
 	}
 	return nil
 }
 
-type SetPlayerGroupsRequest struct {
+func (x *ScheduledAnnouncement) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateScheduledAnnouncementRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid   string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	Entry *ScheduledAnnouncement `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *SetPlayerGroupsRequest) Reset() {
-	*x = SetPlayerGroupsRequest{}
+func (x *CreateScheduledAnnouncementRequest) Reset() {
+	*x = CreateScheduledAnnouncementRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_systera_proto_msgTypes[24]
+		mi := &file_systera_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetPlayerGroupsRequest) String() string {
+func (x *CreateScheduledAnnouncementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlayerGroupsRequest) ProtoMessage() {}
+func (*CreateScheduledAnnouncementRequest) ProtoMessage() {}
 
-func (x *SetPlayerGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systera_proto_msgTypes[24]
+func (x *CreateScheduledAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,36 +1977,76 @@ func (x *SetPlayerGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlayerGroupsRequest.ProtoReflect.Descriptor instead.
-func (*SetPlayerGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_systera_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CreateScheduledAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduledAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SetPlayerGroupsRequest) GetUuid() string {
+func (x *CreateScheduledAnnouncementRequest) GetEntry() *ScheduledAnnouncement {
 	if x != nil {
-		return x.Uuid
+		return x.Entry
 	}
-	return ""
+	return nil
 }
 
-func (x *SetPlayerGroupsRequest) GetGroups() []string {
+type CreateScheduledAnnouncementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *ScheduledAnnouncement `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *CreateScheduledAnnouncementResponse) Reset() {
+	*x = CreateScheduledAnnouncementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateScheduledAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledAnnouncementResponse) ProtoMessage() {}
+
+func (x *CreateScheduledAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduledAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateScheduledAnnouncementResponse) GetEntry() *ScheduledAnnouncement {
 	if x != nil {
-		return x.Groups
+		return x.Entry
 	}
 	return nil
 }
 
-type SetPlayerServerRequest struct {
+type ListScheduledAnnouncementsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid       string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	ServerName string `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// include_finished - include finished one-shot announcements
+	IncludeFinished bool `protobuf:"varint,1,opt,name=include_finished,json=includeFinished,proto3" json:"include_finished,omitempty"`
 }
 
-func (x *SetPlayerServerRequest) Reset() {
-	*x = SetPlayerServerRequest{}
+func (x *ListScheduledAnnouncementsRequest) Reset() {
+	*x = ListScheduledAnnouncementsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1788,13 +2054,13 @@ func (x *SetPlayerServerRequest) Reset() {
 	}
 }
 
-func (x *SetPlayerServerRequest) String() string {
+func (x *ListScheduledAnnouncementsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlayerServerRequest) ProtoMessage() {}
+func (*ListScheduledAnnouncementsRequest) ProtoMessage() {}
 
-func (x *SetPlayerServerRequest) ProtoReflect() protoreflect.Message {
+func (x *ListScheduledAnnouncementsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1806,36 +2072,28 @@ func (x *SetPlayerServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlayerServerRequest.ProtoReflect.Descriptor instead.
-func (*SetPlayerServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListScheduledAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledAnnouncementsRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SetPlayerServerRequest) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *SetPlayerServerRequest) GetServerName() string {
+func (x *ListScheduledAnnouncementsRequest) GetIncludeFinished() bool {
 	if x != nil {
-		return x.ServerName
+		return x.IncludeFinished
 	}
-	return ""
+	return false
 }
 
-type RemovePlayerServerRequest struct {
+type ListScheduledAnnouncementsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid       string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	ServerName string `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Entries []*ScheduledAnnouncement `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *RemovePlayerServerRequest) Reset() {
-	*x = RemovePlayerServerRequest{}
+func (x *ListScheduledAnnouncementsResponse) Reset() {
+	*x = ListScheduledAnnouncementsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1843,13 +2101,13 @@ func (x *RemovePlayerServerRequest) Reset() {
 	}
 }
 
-func (x *RemovePlayerServerRequest) String() string {
+func (x *ListScheduledAnnouncementsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemovePlayerServerRequest) ProtoMessage() {}
+func (*ListScheduledAnnouncementsResponse) ProtoMessage() {}
 
-func (x *RemovePlayerServerRequest) ProtoReflect() protoreflect.Message {
+func (x *ListScheduledAnnouncementsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1861,36 +2119,28 @@ func (x *RemovePlayerServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemovePlayerServerRequest.ProtoReflect.Descriptor instead.
-func (*RemovePlayerServerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListScheduledAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledAnnouncementsResponse) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *RemovePlayerServerRequest) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *RemovePlayerServerRequest) GetServerName() string {
+func (x *ListScheduledAnnouncementsResponse) GetEntries() []*ScheduledAnnouncement {
 	if x != nil {
-		return x.ServerName
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type SetPlayerSettingsRequest struct {
+type DeleteScheduledAnnouncementRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid     string          `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Settings *PlayerSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SetPlayerSettingsRequest) Reset() {
-	*x = SetPlayerSettingsRequest{}
+func (x *DeleteScheduledAnnouncementRequest) Reset() {
+	*x = DeleteScheduledAnnouncementRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1898,13 +2148,13 @@ func (x *SetPlayerSettingsRequest) Reset() {
 	}
 }
 
-func (x *SetPlayerSettingsRequest) String() string {
+func (x *DeleteScheduledAnnouncementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlayerSettingsRequest) ProtoMessage() {}
+func (*DeleteScheduledAnnouncementRequest) ProtoMessage() {}
 
-func (x *SetPlayerSettingsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteScheduledAnnouncementRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1916,39 +2166,31 @@ func (x *SetPlayerSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlayerSettingsRequest.ProtoReflect.Descriptor instead.
-func (*SetPlayerSettingsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteScheduledAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduledAnnouncementRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SetPlayerSettingsRequest) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *SetPlayerSettingsRequest) GetSettings() *PlayerSettings {
+func (x *DeleteScheduledAnnouncementRequest) GetId() uint32 {
 	if x != nil {
-		return x.Settings
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// ALTLOOKUP
-type AddressesEntry struct {
+type ChatEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Hostname  string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	FirstSeen int64  `protobuf:"varint,3,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
-	LastSeen  int64  `protobuf:"varint,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// author - name with diplayName
+	Author     *PlayerIdentity `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	ServerName string          `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Message    string          `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *AddressesEntry) Reset() {
-	*x = AddressesEntry{}
+func (x *ChatEntry) Reset() {
+	*x = ChatEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1956,13 +2198,13 @@ func (x *AddressesEntry) Reset() {
 	}
 }
 
-func (x *AddressesEntry) String() string {
+func (x *ChatEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressesEntry) ProtoMessage() {}
+func (*ChatEntry) ProtoMessage() {}
 
-func (x *AddressesEntry) ProtoReflect() protoreflect.Message {
+func (x *ChatEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1974,51 +2216,42 @@ func (x *AddressesEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddressesEntry.ProtoReflect.Descriptor instead.
-func (*AddressesEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatEntry.ProtoReflect.Descriptor instead.
+func (*ChatEntry) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *AddressesEntry) GetAddress() string {
+func (x *ChatEntry) GetAuthor() *PlayerIdentity {
 	if x != nil {
-		return x.Address
+		return x.Author
 	}
-	return ""
+	return nil
 }
 
-func (x *AddressesEntry) GetHostname() string {
+func (x *ChatEntry) GetServerName() string {
 	if x != nil {
-		return x.Hostname
+		return x.ServerName
 	}
 	return ""
 }
 
-func (x *AddressesEntry) GetFirstSeen() int64 {
-	if x != nil {
-		return x.FirstSeen
-	}
-	return 0
-}
-
-func (x *AddressesEntry) GetLastSeen() int64 {
+func (x *ChatEntry) GetMessage() string {
 	if x != nil {
-		return x.LastSeen
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type AltLookupEntry struct {
+type ChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid      string            `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name      string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Addresses []*AddressesEntry `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Entry *ChatEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *AltLookupEntry) Reset() {
-	*x = AltLookupEntry{}
+func (x *ChatRequest) Reset() {
+	*x = ChatRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2026,13 +2259,13 @@ func (x *AltLookupEntry) Reset() {
 	}
 }
 
-func (x *AltLookupEntry) String() string {
+func (x *ChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AltLookupEntry) ProtoMessage() {}
+func (*ChatRequest) ProtoMessage() {}
 
-func (x *AltLookupEntry) ProtoReflect() protoreflect.Message {
+func (x *ChatRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2044,42 +2277,29 @@ func (x *AltLookupEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AltLookupEntry.ProtoReflect.Descriptor instead.
-func (*AltLookupEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatRequest.ProtoReflect.Descriptor instead.
+func (*ChatRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AltLookupEntry) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *AltLookupEntry) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *AltLookupEntry) GetAddresses() []*AddressesEntry {
+func (x *ChatRequest) GetEntry() *ChatEntry {
 	if x != nil {
-		return x.Addresses
+		return x.Entry
 	}
 	return nil
 }
 
-type AltLookupRequest struct {
+type AddChatIgnoreRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerUuid string `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Uuid   string          `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Target *PlayerIdentity `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (x *AltLookupRequest) Reset() {
-	*x = AltLookupRequest{}
+func (x *AddChatIgnoreRequest) Reset() {
+	*x = AddChatIgnoreRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2087,13 +2307,13 @@ func (x *AltLookupRequest) Reset() {
 	}
 }
 
-func (x *AltLookupRequest) String() string {
+func (x *AddChatIgnoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AltLookupRequest) ProtoMessage() {}
+func (*AddChatIgnoreRequest) ProtoMessage() {}
 
-func (x *AltLookupRequest) ProtoReflect() protoreflect.Message {
+func (x *AddChatIgnoreRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2105,28 +2325,36 @@ func (x *AltLookupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AltLookupRequest.ProtoReflect.Descriptor instead.
-func (*AltLookupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddChatIgnoreRequest.ProtoReflect.Descriptor instead.
+func (*AddChatIgnoreRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *AltLookupRequest) GetPlayerUuid() string {
+func (x *AddChatIgnoreRequest) GetUuid() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.Uuid
 	}
 	return ""
 }
 
-type AltLookupResponse struct {
+func (x *AddChatIgnoreRequest) GetTarget() *PlayerIdentity {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type RemoveChatIgnoreRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*AltLookupEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Uuid   string          `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Target *PlayerIdentity `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (x *AltLookupResponse) Reset() {
-	*x = AltLookupResponse{}
+func (x *RemoveChatIgnoreRequest) Reset() {
+	*x = RemoveChatIgnoreRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2134,13 +2362,13 @@ func (x *AltLookupResponse) Reset() {
 	}
 }
 
-func (x *AltLookupResponse) String() string {
+func (x *RemoveChatIgnoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AltLookupResponse) ProtoMessage() {}
+func (*RemoveChatIgnoreRequest) ProtoMessage() {}
 
-func (x *AltLookupResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveChatIgnoreRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2152,34 +2380,36 @@ func (x *AltLookupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AltLookupResponse.ProtoReflect.Descriptor instead.
-func (*AltLookupResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveChatIgnoreRequest.ProtoReflect.Descriptor instead.
+func (*RemoveChatIgnoreRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AltLookupResponse) GetEntries() []*AltLookupEntry {
+func (x *RemoveChatIgnoreRequest) GetUuid() string {
 	if x != nil {
-		return x.Entries
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *RemoveChatIgnoreRequest) GetTarget() *PlayerIdentity {
+	if x != nil {
+		return x.Target
 	}
 	return nil
 }
 
-type PunishEntry struct {
+type ChatIgnoreResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Available    bool            `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	Level        PunishLevel     `protobuf:"varint,2,opt,name=level,proto3,enum=systerapb.PunishLevel" json:"level,omitempty"`
-	Reason       string          `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	Date         int64           `protobuf:"varint,4,opt,name=date,proto3" json:"date,omitempty"`
-	Expire       int64           `protobuf:"varint,5,opt,name=expire,proto3" json:"expire,omitempty"`
-	PunishedFrom *PlayerIdentity `protobuf:"bytes,6,opt,name=punished_from,json=punishedFrom,proto3" json:"punished_from,omitempty"`
-	PunishedTo   *PlayerIdentity `protobuf:"bytes,7,opt,name=punished_to,json=punishedTo,proto3" json:"punished_to,omitempty"`
+	Result   CallResult      `protobuf:"varint,1,opt,name=result,proto3,enum=systerapb.CallResult" json:"result,omitempty"`
+	Identity *PlayerIdentity `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (x *PunishEntry) Reset() {
-	*x = PunishEntry{}
+func (x *ChatIgnoreResponse) Reset() {
+	*x = ChatIgnoreResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2187,13 +2417,13 @@ func (x *PunishEntry) Reset() {
 	}
 }
 
-func (x *PunishEntry) String() string {
+func (x *ChatIgnoreResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PunishEntry) ProtoMessage() {}
+func (*ChatIgnoreResponse) ProtoMessage() {}
 
-func (x *PunishEntry) ProtoReflect() protoreflect.Message {
+func (x *ChatIgnoreResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2205,72 +2435,39 @@ func (x *PunishEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PunishEntry.ProtoReflect.Descriptor instead.
-func (*PunishEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatIgnoreResponse.ProtoReflect.Descriptor instead.
+func (*ChatIgnoreResponse) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *PunishEntry) GetAvailable() bool {
-	if x != nil {
-		return x.Available
-	}
-	return false
-}
-
-func (x *PunishEntry) GetLevel() PunishLevel {
-	if x != nil {
-		return x.Level
-	}
-	return PunishLevel_UNKNOWN
-}
-
-func (x *PunishEntry) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *PunishEntry) GetDate() int64 {
-	if x != nil {
-		return x.Date
-	}
-	return 0
-}
-
-func (x *PunishEntry) GetExpire() int64 {
-	if x != nil {
-		return x.Expire
-	}
-	return 0
-}
-
-func (x *PunishEntry) GetPunishedFrom() *PlayerIdentity {
+func (x *ChatIgnoreResponse) GetResult() CallResult {
 	if x != nil {
-		return x.PunishedFrom
+		return x.Result
 	}
-	return nil
+	return CallResult_SUCCESS
 }
 
-func (x *PunishEntry) GetPunishedTo() *PlayerIdentity {
+func (x *ChatIgnoreResponse) GetIdentity() *PlayerIdentity {
 	if x != nil {
-		return x.PunishedTo
+		return x.Identity
 	}
 	return nil
 }
 
-type GetPlayerPunishRequest struct {
+type SubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid           string      `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	FilterLevel    PunishLevel `protobuf:"varint,2,opt,name=filter_level,json=filterLevel,proto3,enum=systerapb.PunishLevel" json:"filter_level,omitempty"`
-	IncludeExpired bool        `protobuf:"varint,3,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	// server_name - server this client represents
+	// (receives "global" events and events targeted to this server)
+	ServerName string `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// topics - topics to receive (empty: all topics)
+	Topics []StreamTopic `protobuf:"varint,2,rep,packed,name=topics,proto3,enum=systerapb.StreamTopic" json:"topics,omitempty"`
 }
 
-func (x *GetPlayerPunishRequest) Reset() {
-	*x = GetPlayerPunishRequest{}
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2278,13 +2475,13 @@ func (x *GetPlayerPunishRequest) Reset() {
 	}
 }
 
-func (x *GetPlayerPunishRequest) String() string {
+func (x *SubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlayerPunishRequest) ProtoMessage() {}
+func (*SubscribeRequest) ProtoMessage() {}
 
-func (x *GetPlayerPunishRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2296,42 +2493,45 @@ func (x *GetPlayerPunishRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlayerPunishRequest.ProtoReflect.Descriptor instead.
-func (*GetPlayerPunishRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetPlayerPunishRequest) GetUuid() string {
+func (x *SubscribeRequest) GetServerName() string {
 	if x != nil {
-		return x.Uuid
+		return x.ServerName
 	}
 	return ""
 }
 
-func (x *GetPlayerPunishRequest) GetFilterLevel() PunishLevel {
-	if x != nil {
-		return x.FilterLevel
-	}
-	return PunishLevel_UNKNOWN
-}
-
-func (x *GetPlayerPunishRequest) GetIncludeExpired() bool {
+func (x *SubscribeRequest) GetTopics() []StreamTopic {
 	if x != nil {
-		return x.IncludeExpired
+		return x.Topics
 	}
-	return false
+	return nil
 }
 
-type GetPlayerPunishResponse struct {
+// StreamEvent - Event delivered by Subscribe (same payload as systera.* channels)
+type StreamEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entry []*PunishEntry `protobuf:"bytes,1,rep,name=entry,proto3" json:"entry,omitempty"`
+	// channel - origin channel (ex. systera.system.lobby)
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Types that are assignable to Event:
+	//	*StreamEvent_System
+	//	*StreamEvent_Player
+	//	*StreamEvent_Punishment
+	//	*StreamEvent_Group
+	//	*StreamEvent_Chat
+	//	*StreamEvent_Server
+	Event isStreamEvent_Event `protobuf_oneof:"event"`
 }
 
-func (x *GetPlayerPunishResponse) Reset() {
-	*x = GetPlayerPunishResponse{}
+func (x *StreamEvent) Reset() {
+	*x = StreamEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2339,13 +2539,13 @@ func (x *GetPlayerPunishResponse) Reset() {
 	}
 }
 
-func (x *GetPlayerPunishResponse) String() string {
+func (x *StreamEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlayerPunishResponse) ProtoMessage() {}
+func (*StreamEvent) ProtoMessage() {}
 
-func (x *GetPlayerPunishResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2357,33 +2557,122 @@ func (x *GetPlayerPunishResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlayerPunishResponse.ProtoReflect.Descriptor instead.
-func (*GetPlayerPunishResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamEvent.ProtoReflect.Descriptor instead.
+func (*StreamEvent) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetPlayerPunishResponse) GetEntry() []*PunishEntry {
+func (x *StreamEvent) GetChannel() string {
 	if x != nil {
-		return x.Entry
+		return x.Channel
+	}
+	return ""
+}
+
+func (m *StreamEvent) GetEvent() isStreamEvent_Event {
+	if m != nil {
+		return m.Event
 	}
 	return nil
 }
 
-type SetPlayerPunishRequest struct {
+func (x *StreamEvent) GetSystem() *SystemStream {
+	if x, ok := x.GetEvent().(*StreamEvent_System); ok {
+		return x.System
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetPlayer() *PlayerStream {
+	if x, ok := x.GetEvent().(*StreamEvent_Player); ok {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetPunishment() *PunishmentStream {
+	if x, ok := x.GetEvent().(*StreamEvent_Punishment); ok {
+		return x.Punishment
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetGroup() *GroupStream {
+	if x, ok := x.GetEvent().(*StreamEvent_Group); ok {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetChat() *ChatStream {
+	if x, ok := x.GetEvent().(*StreamEvent_Chat); ok {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetServer() *ServerStream {
+	if x, ok := x.GetEvent().(*StreamEvent_Server); ok {
+		return x.Server
+	}
+	return nil
+}
+
+type isStreamEvent_Event interface {
+	isStreamEvent_Event()
+}
+
+type StreamEvent_System struct {
+	System *SystemStream `protobuf:"bytes,2,opt,name=system,proto3,oneof"`
+}
+
+type StreamEvent_Player struct {
+	Player *PlayerStream `protobuf:"bytes,3,opt,name=player,proto3,oneof"`
+}
+
+type StreamEvent_Punishment struct {
+	Punishment *PunishmentStream `protobuf:"bytes,4,opt,name=punishment,proto3,oneof"`
+}
+
+type StreamEvent_Group struct {
+	Group *GroupStream `protobuf:"bytes,5,opt,name=group,proto3,oneof"`
+}
+
+type StreamEvent_Chat struct {
+	Chat *ChatStream `protobuf:"bytes,6,opt,name=chat,proto3,oneof"`
+}
+
+type StreamEvent_Server struct {
+	Server *ServerStream `protobuf:"bytes,7,opt,name=server,proto3,oneof"`
+}
+
+func (*StreamEvent_System) isStreamEvent_Event() {}
+
+func (*StreamEvent_Player) isStreamEvent_Event() {}
+
+func (*StreamEvent_Punishment) isStreamEvent_Event() {}
+
+func (*StreamEvent_Group) isStreamEvent_Event() {}
+
+func (*StreamEvent_Chat) isStreamEvent_Event() {}
+
+func (*StreamEvent_Server) isStreamEvent_Event() {}
+
+// Journal (Redis Streams): events not acknowledged by server_name
+type ReplayEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// remote - use with stream?
-	Remote bool `protobuf:"varint,1,opt,name=remote,proto3" json:"remote,omitempty"`
-	// force - force punish? (without InitPlayerProfile phase)
-	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	// entry - Punishment details
-	Entry *PunishEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+	ServerName string `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// topics - topics to replay (empty: all topics)
+	Topics []StreamTopic `protobuf:"varint,2,rep,packed,name=topics,proto3,enum=systerapb.StreamTopic" json:"topics,omitempty"`
+	// limit - max events (0: unlimited)
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *SetPlayerPunishRequest) Reset() {
-	*x = SetPlayerPunishRequest{}
+func (x *ReplayEventsRequest) Reset() {
+	*x = ReplayEventsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2391,13 +2680,13 @@ func (x *SetPlayerPunishRequest) Reset() {
 	}
 }
 
-func (x *SetPlayerPunishRequest) String() string {
+func (x *ReplayEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlayerPunishRequest) ProtoMessage() {}
+func (*ReplayEventsRequest) ProtoMessage() {}
 
-func (x *SetPlayerPunishRequest) ProtoReflect() protoreflect.Message {
+func (x *ReplayEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2409,45 +2698,44 @@ func (x *SetPlayerPunishRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlayerPunishRequest.ProtoReflect.Descriptor instead.
-func (*SetPlayerPunishRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplayEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReplayEventsRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SetPlayerPunishRequest) GetRemote() bool {
+func (x *ReplayEventsRequest) GetServerName() string {
 	if x != nil {
-		return x.Remote
+		return x.ServerName
 	}
-	return false
+	return ""
 }
 
-func (x *SetPlayerPunishRequest) GetForce() bool {
+func (x *ReplayEventsRequest) GetTopics() []StreamTopic {
 	if x != nil {
-		return x.Force
+		return x.Topics
 	}
-	return false
+	return nil
 }
 
-func (x *SetPlayerPunishRequest) GetEntry() *PunishEntry {
+func (x *ReplayEventsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Entry
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type SetPlayerPunishResponse struct {
+type JournalEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NoProfile bool `protobuf:"varint,1,opt,name=no_profile,json=noProfile,proto3" json:"no_profile,omitempty"`
-	Offline   bool `protobuf:"varint,2,opt,name=offline,proto3" json:"offline,omitempty"`
-	Duplicate bool `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
-	Cooldown  bool `protobuf:"varint,4,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+	// id - journal entry ID (ack with AckEvents)
+	Id    string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event *StreamEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *SetPlayerPunishResponse) Reset() {
-	*x = SetPlayerPunishResponse{}
+func (x *JournalEvent) Reset() {
+	*x = JournalEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2455,13 +2743,13 @@ func (x *SetPlayerPunishResponse) Reset() {
 	}
 }
 
-func (x *SetPlayerPunishResponse) String() string {
+func (x *JournalEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlayerPunishResponse) ProtoMessage() {}
+func (*JournalEvent) ProtoMessage() {}
 
-func (x *SetPlayerPunishResponse) ProtoReflect() protoreflect.Message {
+func (x *JournalEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2473,49 +2761,35 @@ func (x *SetPlayerPunishResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlayerPunishResponse.ProtoReflect.Descriptor instead.
-func (*SetPlayerPunishResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JournalEvent.ProtoReflect.Descriptor instead.
+func (*JournalEvent) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SetPlayerPunishResponse) GetNoProfile() bool {
-	if x != nil {
-		return x.NoProfile
-	}
-	return false
-}
-
-func (x *SetPlayerPunishResponse) GetOffline() bool {
-	if x != nil {
-		return x.Offline
-	}
-	return false
-}
-
-func (x *SetPlayerPunishResponse) GetDuplicate() bool {
+func (x *JournalEvent) GetId() string {
 	if x != nil {
-		return x.Duplicate
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *SetPlayerPunishResponse) GetCooldown() bool {
+func (x *JournalEvent) GetEvent() *StreamEvent {
 	if x != nil {
-		return x.Cooldown
+		return x.Event
 	}
-	return false
+	return nil
 }
 
-type UnBanRequest struct {
+type ReplayEventsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Target *PlayerIdentity `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Events []*JournalEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *UnBanRequest) Reset() {
-	*x = UnBanRequest{}
+func (x *ReplayEventsResponse) Reset() {
+	*x = ReplayEventsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2523,13 +2797,13 @@ func (x *UnBanRequest) Reset() {
 	}
 }
 
-func (x *UnBanRequest) String() string {
+func (x *ReplayEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnBanRequest) ProtoMessage() {}
+func (*ReplayEventsResponse) ProtoMessage() {}
 
-func (x *UnBanRequest) ProtoReflect() protoreflect.Message {
+func (x *ReplayEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2541,26 +2815,31 @@ func (x *UnBanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnBanRequest.ProtoReflect.Descriptor instead.
-func (*UnBanRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplayEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReplayEventsResponse) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UnBanRequest) GetTarget() *PlayerIdentity {
+func (x *ReplayEventsResponse) GetEvents() []*JournalEvent {
 	if x != nil {
-		return x.Target
+		return x.Events
 	}
 	return nil
 }
 
-type UnBanResponse struct {
+type AckEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ServerName string `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// channel - StreamEvent.channel of acknowledged events
+	Channel string   `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Ids     []string `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *UnBanResponse) Reset() {
-	*x = UnBanResponse{}
+func (x *AckEventsRequest) Reset() {
+	*x = AckEventsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2568,13 +2847,13 @@ func (x *UnBanResponse) Reset() {
 	}
 }
 
-func (x *UnBanResponse) String() string {
+func (x *AckEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnBanResponse) ProtoMessage() {}
+func (*AckEventsRequest) ProtoMessage() {}
 
-func (x *UnBanResponse) ProtoReflect() protoreflect.Message {
+func (x *AckEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2586,25 +2865,46 @@ func (x *UnBanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnBanResponse.ProtoReflect.Descriptor instead.
-func (*UnBanResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AckEventsRequest.ProtoReflect.Descriptor instead.
+func (*AckEventsRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{38}
 }
 
-type ReportEntry struct {
+func (x *AckEventsRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *AckEventsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *AckEventsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// System
+type SystemStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From    *PlayerIdentity `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To      *PlayerIdentity `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Message string          `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Date    int64           `protobuf:"varint,4,opt,name=date,proto3" json:"date,omitempty"`
-	Server  string          `protobuf:"bytes,5,opt,name=server,proto3" json:"server,omitempty"`
+	Type SystemStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.SystemStream_Type" json:"type,omitempty"`
+	Msg  string            `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// id - correlation ID (DISPATCH: report with ReportDispatchResult)
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ReportEntry) Reset() {
-	*x = ReportEntry{}
+func (x *SystemStream) Reset() {
+	*x = SystemStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2612,13 +2912,13 @@ func (x *ReportEntry) Reset() {
 	}
 }
 
-func (x *ReportEntry) String() string {
+func (x *SystemStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportEntry) ProtoMessage() {}
+func (*SystemStream) ProtoMessage() {}
 
-func (x *ReportEntry) ProtoReflect() protoreflect.Message {
+func (x *SystemStream) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2630,59 +2930,44 @@ func (x *ReportEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportEntry.ProtoReflect.Descriptor instead.
-func (*ReportEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use SystemStream.ProtoReflect.Descriptor instead.
+func (*SystemStream) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ReportEntry) GetFrom() *PlayerIdentity {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *ReportEntry) GetTo() *PlayerIdentity {
+func (x *SystemStream) GetType() SystemStream_Type {
 	if x != nil {
-		return x.To
+		return x.Type
 	}
-	return nil
+	return SystemStream_DISPATCH
 }
 
-func (x *ReportEntry) GetMessage() string {
+func (x *SystemStream) GetMsg() string {
 	if x != nil {
-		return x.Message
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *ReportEntry) GetDate() int64 {
-	if x != nil {
-		return x.Date
-	}
-	return 0
-}
-
-func (x *ReportEntry) GetServer() string {
+func (x *SystemStream) GetId() string {
 	if x != nil {
-		return x.Server
+		return x.Id
 	}
 	return ""
 }
 
-type ReportRequest struct {
+// Player
+type PlayerStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From       *PlayerIdentity `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To         *PlayerIdentity `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	ServerName string          `protobuf:"bytes,3,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
-	Message    string          `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Type  PlayerStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.PlayerStream_Type" json:"type,omitempty"`
+	Entry *PlayerEntry      `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *ReportRequest) Reset() {
-	*x = ReportRequest{}
+func (x *PlayerStream) Reset() {
+	*x = PlayerStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2690,13 +2975,13 @@ func (x *ReportRequest) Reset() {
 	}
 }
 
-func (x *ReportRequest) String() string {
+func (x *PlayerStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportRequest) ProtoMessage() {}
+func (*PlayerStream) ProtoMessage() {}
 
-func (x *ReportRequest) ProtoReflect() protoreflect.Message {
+func (x *PlayerStream) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2708,47 +2993,39 @@ func (x *ReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
-func (*ReportRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerStream.ProtoReflect.Descriptor instead.
+func (*PlayerStream) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ReportRequest) GetFrom() *PlayerIdentity {
+func (x *PlayerStream) GetType() PlayerStream_Type {
 	if x != nil {
-		return x.From
+		return x.Type
 	}
-	return nil
+	return PlayerStream_ALL
 }
 
-func (x *ReportRequest) GetTo() *PlayerIdentity {
+func (x *PlayerStream) GetEntry() *PlayerEntry {
 	if x != nil {
-		return x.To
+		return x.Entry
 	}
 	return nil
 }
 
-func (x *ReportRequest) GetServerName() string {
-	if x != nil {
-		return x.ServerName
-	}
-	return ""
-}
-
-func (x *ReportRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type ReportResponse struct {
+// Punishment
+type PunishmentStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Type              PunishmentStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.PunishmentStream_Type" json:"type,omitempty"`
+	PunishStreamEntry *PunishStreamEntry    `protobuf:"bytes,2,opt,name=punish_stream_entry,json=punishStreamEntry,proto3" json:"punish_stream_entry,omitempty"`
+	ReportEntry       *ReportEntry          `protobuf:"bytes,3,opt,name=report_entry,json=reportEntry,proto3" json:"report_entry,omitempty"`
+	AppealEntry       *AppealEntry          `protobuf:"bytes,4,opt,name=appeal_entry,json=appealEntry,proto3" json:"appeal_entry,omitempty"`
 }
 
-func (x *ReportResponse) Reset() {
-	*x = ReportResponse{}
+func (x *PunishmentStream) Reset() {
+	*x = PunishmentStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2756,13 +3033,13 @@ func (x *ReportResponse) Reset() {
 	}
 }
 
-func (x *ReportResponse) String() string {
+func (x *PunishmentStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportResponse) ProtoMessage() {}
+func (*PunishmentStream) ProtoMessage() {}
 
-func (x *ReportResponse) ProtoReflect() protoreflect.Message {
+func (x *PunishmentStream) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2774,24 +3051,50 @@ func (x *ReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportResponse.ProtoReflect.Descriptor instead.
-func (*ReportResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PunishmentStream.ProtoReflect.Descriptor instead.
+func (*PunishmentStream) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{41}
 }
 
-// GROUP PERMISISONS
-type GroupEntry struct {
+func (x *PunishmentStream) GetType() PunishmentStream_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PunishmentStream_PUNISH
+}
+
+func (x *PunishmentStream) GetPunishStreamEntry() *PunishStreamEntry {
+	if x != nil {
+		return x.PunishStreamEntry
+	}
+	return nil
+}
+
+func (x *PunishmentStream) GetReportEntry() *ReportEntry {
+	if x != nil {
+		return x.ReportEntry
+	}
+	return nil
+}
+
+func (x *PunishmentStream) GetAppealEntry() *AppealEntry {
+	if x != nil {
+		return x.AppealEntry
+	}
+	return nil
+}
+
+type PunishStreamEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupName   string              `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	GroupPrefix string              `protobuf:"bytes,2,opt,name=group_prefix,json=groupPrefix,proto3" json:"group_prefix,omitempty"`
-	Permissions []*PermissionsEntry `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Entry          *PunishEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	RequireExecute bool         `protobuf:"varint,2,opt,name=require_execute,json=requireExecute,proto3" json:"require_execute,omitempty"`
 }
 
-func (x *GroupEntry) Reset() {
-	*x = GroupEntry{}
+func (x *PunishStreamEntry) Reset() {
+	*x = PunishStreamEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2799,13 +3102,13 @@ func (x *GroupEntry) Reset() {
 	}
 }
 
-func (x *GroupEntry) String() string {
+func (x *PunishStreamEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupEntry) ProtoMessage() {}
+func (*PunishStreamEntry) ProtoMessage() {}
 
-func (x *GroupEntry) ProtoReflect() protoreflect.Message {
+func (x *PunishStreamEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2817,43 +3120,37 @@ func (x *GroupEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupEntry.ProtoReflect.Descriptor instead.
-func (*GroupEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use PunishStreamEntry.ProtoReflect.Descriptor instead.
+func (*PunishStreamEntry) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GroupEntry) GetGroupName() string {
-	if x != nil {
-		return x.GroupName
-	}
-	return ""
-}
-
-func (x *GroupEntry) GetGroupPrefix() string {
+func (x *PunishStreamEntry) GetEntry() *PunishEntry {
 	if x != nil {
-		return x.GroupPrefix
+		return x.Entry
 	}
-	return ""
+	return nil
 }
 
-func (x *GroupEntry) GetPermissions() []*PermissionsEntry {
+func (x *PunishStreamEntry) GetRequireExecute() bool {
 	if x != nil {
-		return x.Permissions
+		return x.RequireExecute
 	}
-	return nil
+	return false
 }
 
-type PermissionsEntry struct {
+// Group
+type GroupStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerName  string   `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
-	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Type       GroupStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.GroupStream_Type" json:"type,omitempty"`
+	GroupEntry *GroupEntry      `protobuf:"bytes,2,opt,name=group_entry,json=groupEntry,proto3" json:"group_entry,omitempty"`
 }
 
-func (x *PermissionsEntry) Reset() {
-	*x = PermissionsEntry{}
+func (x *GroupStream) Reset() {
+	*x = GroupStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2861,13 +3158,13 @@ func (x *PermissionsEntry) Reset() {
 	}
 }
 
-func (x *PermissionsEntry) String() string {
+func (x *GroupStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PermissionsEntry) ProtoMessage() {}
+func (*GroupStream) ProtoMessage() {}
 
-func (x *PermissionsEntry) ProtoReflect() protoreflect.Message {
+func (x *GroupStream) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2879,33 +3176,37 @@ func (x *PermissionsEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PermissionsEntry.ProtoReflect.Descriptor instead.
-func (*PermissionsEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupStream.ProtoReflect.Descriptor instead.
+func (*GroupStream) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *PermissionsEntry) GetServerName() string {
+func (x *GroupStream) GetType() GroupStream_Type {
 	if x != nil {
-		return x.ServerName
+		return x.Type
 	}
-	return ""
+	return GroupStream_GROUP
 }
 
-func (x *PermissionsEntry) GetPermissions() []string {
+func (x *GroupStream) GetGroupEntry() *GroupEntry {
 	if x != nil {
-		return x.Permissions
+		return x.GroupEntry
 	}
 	return nil
 }
 
-type FetchGroupsRequest struct {
+// Server (systera.server.global)
+type ServerStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Type  ServerStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.ServerStream_Type" json:"type,omitempty"`
+	Entry *ServerEntry      `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *FetchGroupsRequest) Reset() {
-	*x = FetchGroupsRequest{}
+func (x *ServerStream) Reset() {
+	*x = ServerStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2913,13 +3214,13 @@ func (x *FetchGroupsRequest) Reset() {
 	}
 }
 
-func (x *FetchGroupsRequest) String() string {
+func (x *ServerStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchGroupsRequest) ProtoMessage() {}
+func (*ServerStream) ProtoMessage() {}
 
-func (x *FetchGroupsRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerStream) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2931,35 +3232,51 @@ func (x *FetchGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchGroupsRequest.ProtoReflect.Descriptor instead.
-func (*FetchGroupsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerStream.ProtoReflect.Descriptor instead.
+func (*ServerStream) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{44}
 }
 
-type FetchGroupsResponse struct {
+func (x *ServerStream) GetType() ServerStream_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ServerStream_UP
+}
+
+func (x *ServerStream) GetEntry() *ServerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// TextMessage
+type ChatStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Groups []*GroupEntry `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Type      ChatStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=systerapb.ChatStream_Type" json:"type,omitempty"`
+	ChatEntry *ChatEntry      `protobuf:"bytes,2,opt,name=chat_entry,json=chatEntry,proto3" json:"chat_entry,omitempty"`
 }
 
-func (x *FetchGroupsResponse) Reset() {
-	*x = FetchGroupsResponse{}
-	if protoimpl.UnsafeEnabled {
+func (x *ChatStream) Reset() {
+	*x = ChatStream{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchGroupsResponse) String() string {
+func (x *ChatStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchGroupsResponse) ProtoMessage() {}
+func (*ChatStream) ProtoMessage() {}
 
-func (x *FetchGroupsResponse) ProtoReflect() protoreflect.Message {
+func (x *ChatStream) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2971,28 +3288,37 @@ func (x *FetchGroupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchGroupsResponse.ProtoReflect.Descriptor instead.
-func (*FetchGroupsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChatStream.ProtoReflect.Descriptor instead.
+func (*ChatStream) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *FetchGroupsResponse) GetGroups() []*GroupEntry {
+func (x *ChatStream) GetType() ChatStream_Type {
 	if x != nil {
-		return x.Groups
+		return x.Type
+	}
+	return ChatStream_CHAT
+}
+
+func (x *ChatStream) GetChatEntry() *ChatEntry {
+	if x != nil {
+		return x.ChatEntry
 	}
 	return nil
 }
 
-type CreateGroupRequest struct {
+// PLAYER PROFILE
+type PlayerIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupEntry *GroupEntry `protobuf:"bytes,1,opt,name=group_entry,json=groupEntry,proto3" json:"group_entry,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
+func (x *PlayerIdentity) Reset() {
+	*x = PlayerIdentity{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3000,13 +3326,13 @@ func (x *CreateGroupRequest) Reset() {
 	}
 }
 
-func (x *CreateGroupRequest) String() string {
+func (x *PlayerIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRequest) ProtoMessage() {}
+func (*PlayerIdentity) ProtoMessage() {}
 
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *PlayerIdentity) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3018,28 +3344,35 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerIdentity.ProtoReflect.Descriptor instead.
+func (*PlayerIdentity) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CreateGroupRequest) GetGroupEntry() *GroupEntry {
+func (x *PlayerIdentity) GetUuid() string {
 	if x != nil {
-		return x.GroupEntry
+		return x.Uuid
 	}
-	return nil
+	return ""
 }
 
-type RemoveGroupRequest struct {
+func (x *PlayerIdentity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetPlayerIdentityByNameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupName string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *RemoveGroupRequest) Reset() {
-	*x = RemoveGroupRequest{}
+func (x *GetPlayerIdentityByNameRequest) Reset() {
+	*x = GetPlayerIdentityByNameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3047,13 +3380,13 @@ func (x *RemoveGroupRequest) Reset() {
 	}
 }
 
-func (x *RemoveGroupRequest) String() string {
+func (x *GetPlayerIdentityByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveGroupRequest) ProtoMessage() {}
+func (*GetPlayerIdentityByNameRequest) ProtoMessage() {}
 
-func (x *RemoveGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPlayerIdentityByNameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3065,28 +3398,29 @@ func (x *RemoveGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveGroupRequest.ProtoReflect.Descriptor instead.
-func (*RemoveGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPlayerIdentityByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerIdentityByNameRequest) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RemoveGroupRequest) GetGroupName() string {
+func (x *GetPlayerIdentityByNameRequest) GetName() string {
 	if x != nil {
-		return x.GroupName
+		return x.Name
 	}
 	return ""
 }
 
-type UpdateGroupRequest struct {
+type GetPlayerIdentityByNameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupEntry *GroupEntry `protobuf:"bytes,1,opt,name=group_entry,json=groupEntry,proto3" json:"group_entry,omitempty"`
+	Identity *PlayerIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Exists   bool            `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
 }
 
-func (x *UpdateGroupRequest) Reset() {
-	*x = UpdateGroupRequest{}
+func (x *GetPlayerIdentityByNameResponse) Reset() {
+	*x = GetPlayerIdentityByNameResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3094,13 +3428,13 @@ func (x *UpdateGroupRequest) Reset() {
 	}
 }
 
-func (x *UpdateGroupRequest) String() string {
+func (x *GetPlayerIdentityByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupRequest) ProtoMessage() {}
+func (*GetPlayerIdentityByNameResponse) ProtoMessage() {}
 
-func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPlayerIdentityByNameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3112,30 +3446,38 @@ func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPlayerIdentityByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerIdentityByNameResponse) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UpdateGroupRequest) GetGroupEntry() *GroupEntry {
+func (x *GetPlayerIdentityByNameResponse) GetIdentity() *PlayerIdentity {
 	if x != nil {
-		return x.GroupEntry
+		return x.Identity
 	}
 	return nil
 }
 
-type AddPermissionRequest struct {
+func (x *GetPlayerIdentityByNameResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type PlayerSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupName   string   `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	Target      string   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	JoinMessage bool `protobuf:"varint,1,opt,name=join_message,json=joinMessage,proto3" json:"join_message,omitempty"`
+	Vanish      bool `protobuf:"varint,2,opt,name=vanish,proto3" json:"vanish,omitempty"`
+	Japanize    bool `protobuf:"varint,3,opt,name=japanize,proto3" json:"japanize,omitempty"`
+	GlobalChat  bool `protobuf:"varint,4,opt,name=global_chat,json=globalChat,proto3" json:"global_chat,omitempty"`
 }
 
-func (x *AddPermissionRequest) Reset() {
-	*x = AddPermissionRequest{}
+func (x *PlayerSettings) Reset() {
+	*x = PlayerSettings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3143,13 +3485,13 @@ func (x *AddPermissionRequest) Reset() {
 	}
 }
 
-func (x *AddPermissionRequest) String() string {
+func (x *PlayerSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPermissionRequest) ProtoMessage() {}
+func (*PlayerSettings) ProtoMessage() {}
 
-func (x *AddPermissionRequest) ProtoReflect() protoreflect.Message {
+func (x *PlayerSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3161,44 +3503,56 @@ func (x *AddPermissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPermissionRequest.ProtoReflect.Descriptor instead.
-func (*AddPermissionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerSettings.ProtoReflect.Descriptor instead.
+func (*PlayerSettings) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *AddPermissionRequest) GetGroupName() string {
+func (x *PlayerSettings) GetJoinMessage() bool {
 	if x != nil {
-		return x.GroupName
+		return x.JoinMessage
 	}
-	return ""
+	return false
 }
 
-func (x *AddPermissionRequest) GetTarget() string {
+func (x *PlayerSettings) GetVanish() bool {
 	if x != nil {
-		return x.Target
+		return x.Vanish
 	}
-	return ""
+	return false
 }
 
-func (x *AddPermissionRequest) GetPermissions() []string {
+func (x *PlayerSettings) GetJapanize() bool {
 	if x != nil {
-		return x.Permissions
+		return x.Japanize
 	}
-	return nil
+	return false
 }
 
-type RemovePermissionRequest struct {
+func (x *PlayerSettings) GetGlobalChat() bool {
+	if x != nil {
+		return x.GlobalChat
+	}
+	return false
+}
+
+type PlayerEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupName   string   `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	Target      string   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Uuid          string            `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name          string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CurrentServer string            `protobuf:"bytes,3,opt,name=current_server,json=currentServer,proto3" json:"current_server,omitempty"`
+	FirstLogin    int64             `protobuf:"varint,4,opt,name=first_login,json=firstLogin,proto3" json:"first_login,omitempty"`
+	LastLogin     int64             `protobuf:"varint,5,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"`
+	Groups        []string          `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
+	Settings      *PlayerSettings   `protobuf:"bytes,7,opt,name=settings,proto3" json:"settings,omitempty"`
+	PlayerIgnore  []*PlayerIdentity `protobuf:"bytes,8,rep,name=player_ignore,json=playerIgnore,proto3" json:"player_ignore,omitempty"`
 }
 
-func (x *RemovePermissionRequest) Reset() {
-	*x = RemovePermissionRequest{}
+func (x *PlayerEntry) Reset() {
+	*x = PlayerEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_systera_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3206,13 +3560,13 @@ func (x *RemovePermissionRequest) Reset() {
 	}
 }
 
-func (x *RemovePermissionRequest) String() string {
+func (x *PlayerEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemovePermissionRequest) ProtoMessage() {}
+func (*PlayerEntry) ProtoMessage() {}
 
-func (x *RemovePermissionRequest) ProtoReflect() protoreflect.Message {
+func (x *PlayerEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_systera_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3224,678 +3578,6520 @@ func (x *RemovePermissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemovePermissionRequest.ProtoReflect.Descriptor instead.
-func (*RemovePermissionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerEntry.ProtoReflect.Descriptor instead.
+func (*PlayerEntry) Descriptor() ([]byte, []int) {
 	return file_systera_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *RemovePermissionRequest) GetGroupName() string {
+func (x *PlayerEntry) GetUuid() string {
 	if x != nil {
-		return x.GroupName
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *RemovePermissionRequest) GetTarget() string {
+func (x *PlayerEntry) GetName() string {
 	if x != nil {
-		return x.Target
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RemovePermissionRequest) GetPermissions() []string {
+func (x *PlayerEntry) GetCurrentServer() string {
 	if x != nil {
-		return x.Permissions
+		return x.CurrentServer
+	}
+	return ""
+}
+
+func (x *PlayerEntry) GetFirstLogin() int64 {
+	if x != nil {
+		return x.FirstLogin
+	}
+	return 0
+}
+
+func (x *PlayerEntry) GetLastLogin() int64 {
+	if x != nil {
+		return x.LastLogin
+	}
+	return 0
+}
+
+func (x *PlayerEntry) GetGroups() []string {
+	if x != nil {
+		return x.Groups
 	}
 	return nil
 }
 
-var File_systera_proto protoreflect.FileDescriptor
+func (x *PlayerEntry) GetSettings() *PlayerSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
 
-var file_systera_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x09, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x43, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3b, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x70,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x63, 0x6d, 0x64, 0x22, 0x79, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x39, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2a, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x5d, 0x0a, 0x14, 0x41,
-	0x64, 0x64, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
-	0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x60, 0x0a, 0x17, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x7a, 0x0a, 0x12,
-	0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43,
-	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x76, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
-	0x70, 0x62, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x22, 0x0a, 0x04,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43, 0x48,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4e, 0x4e, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x10, 0x01,
-	0x22, 0x99, 0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1c, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
-	0x79, 0x22, 0x29, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c,
-	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x53, 0x10, 0x01, 0x12, 0x0c,
-	0x0a, 0x08, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x02, 0x22, 0xf1, 0x01, 0x0a,
-	0x10, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x20, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69,
-	0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x70, 0x75, 0x6e, 0x69, 0x73,
-	0x68, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
-	0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x11, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x22, 0x1e, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x4e, 0x49,
-	0x53, 0x48, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x01,
-	0x22, 0x6a, 0x0a, 0x11, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
-	0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x22, 0x9a, 0x01, 0x0a,
-	0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2f, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a,
-	0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x22, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a,
-	0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x45, 0x52, 0x4d,
-	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x01, 0x22, 0x83, 0x01, 0x0a, 0x0a, 0x43, 0x68,
-	0x61, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
-	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x74,
-	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x10, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x48, 0x41, 0x54, 0x10, 0x00, 0x22,
-	0x38, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x1e, 0x47, 0x65, 0x74,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x70, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
-	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6e, 0x69, 0x73,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76, 0x61, 0x6e, 0x69, 0x73, 0x68, 0x12,
-	0x1a, 0x0a, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x22, 0xab, 0x02, 0x0a,
-	0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
-	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x22, 0x7d, 0x0a, 0x18, 0x49, 0x6e,
-	0x69, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x19, 0x49, 0x6e, 0x69,
-	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
-	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65,
-	0x6e, 0x74, 0x72, 0x79, 0x22, 0x2f, 0x0a, 0x19, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x1f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x1a,
-	0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e,
-	0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x44, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x4d,
-	0x0a, 0x16, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a,
-	0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x65, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
-	0x35, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x0e, 0x41,
-	0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x33,
-	0x0a, 0x10, 0x41, 0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55,
-	0x75, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x11, 0x41, 0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x99, 0x02,
-	0x0a, 0x0b, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x3e, 0x0a,
-	0x0d, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
-	0x0c, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3a, 0x0a,
-	0x0b, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x70,
-	0x75, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
-	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73,
-	0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x47, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
-	0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x74, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2c, 0x0a,
-	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x8c, 0x01, 0x0a, 0x17,
-	0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0x41, 0x0a, 0x0c, 0x55, 0x6e,
-	0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x0f, 0x0a,
-	0x0d, 0x55, 0x6e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xad,
-	0x01, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2d,
-	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x29, 0x0a,
-	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0xa4,
-	0x01, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2d, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
-	0x29, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x55, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x14,
-	0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x13, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x4c, 0x0a, 0x12, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x36, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
-	0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x33, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a,
-	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x6e, 0x74,
-	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x6f, 0x0a, 0x14, 0x41,
-	0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x72, 0x0a, 0x17,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2a, 0x38, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0b,
-	0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x55,
-	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x48, 0x0a, 0x0b, 0x50, 0x75,
-	0x6e, 0x69, 0x73, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x01,
-	0x12, 0x08, 0x0a, 0x04, 0x4b, 0x49, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45,
-	0x4d, 0x50, 0x42, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x52, 0x4d, 0x42,
-	0x41, 0x4e, 0x10, 0x04, 0x32, 0xde, 0x0e, 0x0a, 0x07, 0x53, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
-	0x12, 0x3a, 0x0a, 0x08, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x08,
-	0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x72, 0x61, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74,
-	0x12, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d,
-	0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x2e,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61,
-	0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49,
-	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x57, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e,
-	0x6f, 0x72, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
-	0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x29, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
-	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11,
-	0x49, 0x6e, 0x69, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x23, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x6e,
-	0x69, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
-	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63,
-	0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
-	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x18, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2a, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63,
-	0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
-	0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0f,
-	0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
-	0x21, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x4a, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
-	0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46,
-	0x0a, 0x09, 0x41, 0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x1b, 0x2e, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
-	0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
-	0x75, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75,
-	0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c,
-	0x0a, 0x05, 0x55, 0x6e, 0x42, 0x61, 0x6e, 0x12, 0x17, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
-	0x61, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x42,
-	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x06,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a,
-	0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1d, 0x2e, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x40, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d,
-	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x12, 0x1d, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
-	0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x10, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e,
-	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x37, 0x0a, 0x19, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x79, 0x6e,
-	0x63, 0x68, 0x74, 0x68, 0x69, 0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x72, 0x61, 0x42, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x5a, 0x0b, 0x2e, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *PlayerEntry) GetPlayerIgnore() []*PlayerIdentity {
+	if x != nil {
+		return x.PlayerIgnore
+	}
+	return nil
 }
 
-var (
-	file_systera_proto_rawDescOnce sync.Once
-	file_systera_proto_rawDescData = file_systera_proto_rawDesc
-)
+type InitPlayerProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_systera_proto_rawDescGZIP() []byte {
-	file_systera_proto_rawDescOnce.Do(func() {
-		file_systera_proto_rawDescData = protoimpl.X.CompressGZIP(file_systera_proto_rawDescData)
-	})
-	return file_systera_proto_rawDescData
+	Uuid      string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IpAddress string `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Hostname  string `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
 }
 
-var file_systera_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_systera_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
-var file_systera_proto_goTypes = []interface{}{
-	(CallResult)(0),                         // 0: systerapb.CallResult
-	(PunishLevel)(0),                        // 1: systerapb.PunishLevel
-	(SystemStream_Type)(0),                  // 2: systerapb.SystemStream.Type
-	(PlayerStream_Type)(0),                  // 3: systerapb.PlayerStream.Type
-	(PunishmentStream_Type)(0),              // 4: systerapb.PunishmentStream.Type
-	(GroupStream_Type)(0),                   // 5: systerapb.GroupStream.Type
-	(ChatStream_Type)(0),                    // 6: systerapb.ChatStream.Type
-	(*Empty)(nil),                           // 7: systerapb.Empty
-	(*AnnounceRequest)(nil),                 // 8: systerapb.AnnounceRequest
-	(*DispatchRequest)(nil),                 // 9: systerapb.DispatchRequest
-	(*ChatEntry)(nil),                       // 10: systerapb.ChatEntry
-	(*ChatRequest)(nil),                     // 11: systerapb.ChatRequest
-	(*AddChatIgnoreRequest)(nil),            // 12: systerapb.AddChatIgnoreRequest
-	(*RemoveChatIgnoreRequest)(nil),         // 13: systerapb.RemoveChatIgnoreRequest
-	(*ChatIgnoreResponse)(nil),              // 14: systerapb.ChatIgnoreResponse
-	(*SystemStream)(nil),                    // 15: systerapb.SystemStream
-	(*PlayerStream)(nil),                    // 16: systerapb.PlayerStream
-	(*PunishmentStream)(nil),                // 17: systerapb.PunishmentStream
-	(*PunishStreamEntry)(nil),               // 18: systerapb.PunishStreamEntry
-	(*GroupStream)(nil),                     // 19: systerapb.GroupStream
-	(*ChatStream)(nil),                      // 20: systerapb.ChatStream
-	(*PlayerIdentity)(nil),                  // 21: systerapb.PlayerIdentity
-	(*GetPlayerIdentityByNameRequest)(nil),  // 22: systerapb.GetPlayerIdentityByNameRequest
-	(*GetPlayerIdentityByNameResponse)(nil), // 23: systerapb.GetPlayerIdentityByNameResponse
-	(*PlayerSettings)(nil),                  // 24: systerapb.PlayerSettings
-	(*PlayerEntry)(nil),                     // 25: systerapb.PlayerEntry
-	(*InitPlayerProfileRequest)(nil),        // 26: systerapb.InitPlayerProfileRequest
-	(*InitPlayerProfileResponse)(nil),       // 27: systerapb.InitPlayerProfileResponse
-	(*FetchPlayerProfileRequest)(nil),       // 28: systerapb.FetchPlayerProfileRequest
-	(*FetchPlayerProfileByNameRequest)(nil), // 29: systerapb.FetchPlayerProfileByNameRequest
-	(*FetchPlayerProfileResponse)(nil),      // 30: systerapb.FetchPlayerProfileResponse
-	(*SetPlayerGroupsRequest)(nil),          // 31: systerapb.SetPlayerGroupsRequest
-	(*SetPlayerServerRequest)(nil),          // 32: systerapb.SetPlayerServerRequest
-	(*RemovePlayerServerRequest)(nil),       // 33: systerapb.RemovePlayerServerRequest
-	(*SetPlayerSettingsRequest)(nil),        // 34: systerapb.SetPlayerSettingsRequest
-	(*AddressesEntry)(nil),                  // 35: systerapb.AddressesEntry
-	(*AltLookupEntry)(nil),                  // 36: systerapb.AltLookupEntry
-	(*AltLookupRequest)(nil),                // 37: systerapb.AltLookupRequest
-	(*AltLookupResponse)(nil),               // 38: systerapb.AltLookupResponse
-	(*PunishEntry)(nil),                     // 39: systerapb.PunishEntry
-	(*GetPlayerPunishRequest)(nil),          // 40: systerapb.GetPlayerPunishRequest
-	(*GetPlayerPunishResponse)(nil),         // 41: systerapb.GetPlayerPunishResponse
-	(*SetPlayerPunishRequest)(nil),          // 42: systerapb.SetPlayerPunishRequest
-	(*SetPlayerPunishResponse)(nil),         // 43: systerapb.SetPlayerPunishResponse
-	(*UnBanRequest)(nil),                    // 44: systerapb.UnBanRequest
-	(*UnBanResponse)(nil),                   // 45: systerapb.UnBanResponse
-	(*ReportEntry)(nil),                     // 46: systerapb.ReportEntry
-	(*ReportRequest)(nil),                   // 47: systerapb.ReportRequest
-	(*ReportResponse)(nil),                  // 48: systerapb.ReportResponse
-	(*GroupEntry)(nil),                      // 49: systerapb.GroupEntry
-	(*PermissionsEntry)(nil),                // 50: systerapb.PermissionsEntry
-	(*FetchGroupsRequest)(nil),              // 51: systerapb.FetchGroupsRequest
-	(*FetchGroupsResponse)(nil),             // 52: systerapb.FetchGroupsResponse
-	(*CreateGroupRequest)(nil),              // 53: systerapb.CreateGroupRequest
-	(*RemoveGroupRequest)(nil),              // 54: systerapb.RemoveGroupRequest
-	(*UpdateGroupRequest)(nil),              // 55: systerapb.UpdateGroupRequest
-	(*AddPermissionRequest)(nil),            // 56: systerapb.AddPermissionRequest
-	(*RemovePermissionRequest)(nil),         // 57: systerapb.RemovePermissionRequest
+func (x *InitPlayerProfileRequest) Reset() {
+	*x = InitPlayerProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_systera_proto_depIdxs = []int32{
-	21, // 0: systerapb.ChatEntry.author:type_name -> systerapb.PlayerIdentity
-	10, // 1: systerapb.ChatRequest.entry:type_name -> systerapb.ChatEntry
-	21, // 2: systerapb.AddChatIgnoreRequest.target:type_name -> systerapb.PlayerIdentity
-	21, // 3: systerapb.RemoveChatIgnoreRequest.target:type_name -> systerapb.PlayerIdentity
-	0,  // 4: systerapb.ChatIgnoreResponse.result:type_name -> systerapb.CallResult
-	21, // 5: systerapb.ChatIgnoreResponse.identity:type_name -> systerapb.PlayerIdentity
-	2,  // 6: systerapb.SystemStream.type:type_name -> systerapb.SystemStream.Type
-	3,  // 7: systerapb.PlayerStream.type:type_name -> systerapb.PlayerStream.Type
-	25, // 8: systerapb.PlayerStream.entry:type_name -> systerapb.PlayerEntry
-	4,  // 9: systerapb.PunishmentStream.type:type_name -> systerapb.PunishmentStream.Type
-	18, // 10: systerapb.PunishmentStream.punish_stream_entry:type_name -> systerapb.PunishStreamEntry
-	46, // 11: systerapb.PunishmentStream.report_entry:type_name -> systerapb.ReportEntry
-	39, // 12: systerapb.PunishStreamEntry.entry:type_name -> systerapb.PunishEntry
-	5,  // 13: systerapb.GroupStream.type:type_name -> systerapb.GroupStream.Type
-	49, // 14: systerapb.GroupStream.group_entry:type_name -> systerapb.GroupEntry
-	6,  // 15: systerapb.ChatStream.type:type_name -> systerapb.ChatStream.Type
-	10, // 16: systerapb.ChatStream.chat_entry:type_name -> systerapb.ChatEntry
-	21, // 17: systerapb.GetPlayerIdentityByNameResponse.identity:type_name -> systerapb.PlayerIdentity
-	24, // 18: systerapb.PlayerEntry.settings:type_name -> systerapb.PlayerSettings
-	21, // 19: systerapb.PlayerEntry.player_ignore:type_name -> systerapb.PlayerIdentity
-	25, // 20: systerapb.InitPlayerProfileResponse.entry:type_name -> systerapb.PlayerEntry
-	25, // 21: systerapb.FetchPlayerProfileResponse.entry:type_name -> systerapb.PlayerEntry
-	24, // 22: systerapb.SetPlayerSettingsRequest.settings:type_name -> systerapb.PlayerSettings
-	35, // 23: systerapb.AltLookupEntry.addresses:type_name -> systerapb.AddressesEntry
-	36, // 24: systerapb.AltLookupResponse.entries:type_name -> systerapb.AltLookupEntry
-	1,  // 25: systerapb.PunishEntry.level:type_name -> systerapb.PunishLevel
-	21, // 26: systerapb.PunishEntry.punished_from:type_name -> systerapb.PlayerIdentity
-	21, // 27: systerapb.PunishEntry.punished_to:type_name -> systerapb.PlayerIdentity
-	1,  // 28: systerapb.GetPlayerPunishRequest.filter_level:type_name -> systerapb.PunishLevel
-	39, // 29: systerapb.GetPlayerPunishResponse.entry:type_name -> systerapb.PunishEntry
-	39, // 30: systerapb.SetPlayerPunishRequest.entry:type_name -> systerapb.PunishEntry
-	21, // 31: systerapb.UnBanRequest.target:type_name -> systerapb.PlayerIdentity
-	21, // 32: systerapb.ReportEntry.from:type_name -> systerapb.PlayerIdentity
-	21, // 33: systerapb.ReportEntry.to:type_name -> systerapb.PlayerIdentity
-	21, // 34: systerapb.ReportRequest.from:type_name -> systerapb.PlayerIdentity
-	21, // 35: systerapb.ReportRequest.to:type_name -> systerapb.PlayerIdentity
-	50, // 36: systerapb.GroupEntry.permissions:type_name -> systerapb.PermissionsEntry
-	49, // 37: systerapb.FetchGroupsResponse.groups:type_name -> systerapb.GroupEntry
-	49, // 38: systerapb.CreateGroupRequest.group_entry:type_name -> systerapb.GroupEntry
-	49, // 39: systerapb.UpdateGroupRequest.group_entry:type_name -> systerapb.GroupEntry
-	8,  // 40: systerapb.Systera.Announce:input_type -> systerapb.AnnounceRequest
-	9,  // 41: systerapb.Systera.Dispatch:input_type -> systerapb.DispatchRequest
-	11, // 42: systerapb.Systera.Chat:input_type -> systerapb.ChatRequest
-	12, // 43: systerapb.Systera.AddChatIgnore:input_type -> systerapb.AddChatIgnoreRequest
-	13, // 44: systerapb.Systera.RemoveChatIgnore:input_type -> systerapb.RemoveChatIgnoreRequest
-	22, // 45: systerapb.Systera.GetPlayerIdentityByName:input_type -> systerapb.GetPlayerIdentityByNameRequest
-	26, // 46: systerapb.Systera.InitPlayerProfile:input_type -> systerapb.InitPlayerProfileRequest
-	28, // 47: systerapb.Systera.FetchPlayerProfile:input_type -> systerapb.FetchPlayerProfileRequest
-	29, // 48: systerapb.Systera.FetchPlayerProfileByName:input_type -> systerapb.FetchPlayerProfileByNameRequest
-	31, // 49: systerapb.Systera.SetPlayerGroups:input_type -> systerapb.SetPlayerGroupsRequest
-	32, // 50: systerapb.Systera.SetPlayerServer:input_type -> systerapb.SetPlayerServerRequest
-	33, // 51: systerapb.Systera.RemovePlayerServer:input_type -> systerapb.RemovePlayerServerRequest
-	34, // 52: systerapb.Systera.SetPlayerSettings:input_type -> systerapb.SetPlayerSettingsRequest
-	37, // 53: systerapb.Systera.AltLookup:input_type -> systerapb.AltLookupRequest
-	40, // 54: systerapb.Systera.GetPlayerPunish:input_type -> systerapb.GetPlayerPunishRequest
-	42, // 55: systerapb.Systera.SetPlayerPunish:input_type -> systerapb.SetPlayerPunishRequest
-	44, // 56: systerapb.Systera.UnBan:input_type -> systerapb.UnBanRequest
-	47, // 57: systerapb.Systera.Report:input_type -> systerapb.ReportRequest
-	51, // 58: systerapb.Systera.FetchGroups:input_type -> systerapb.FetchGroupsRequest
-	53, // 59: systerapb.Systera.CreateGroup:input_type -> systerapb.CreateGroupRequest
-	54, // 60: systerapb.Systera.RemoveGroup:input_type -> systerapb.RemoveGroupRequest
-	55, // 61: systerapb.Systera.UpdateGroup:input_type -> systerapb.UpdateGroupRequest
-	56, // 62: systerapb.Systera.AddPermission:input_type -> systerapb.AddPermissionRequest
-	57, // 63: systerapb.Systera.RemovePermission:input_type -> systerapb.RemovePermissionRequest
-	7,  // 64: systerapb.Systera.Announce:output_type -> systerapb.Empty
-	7,  // 65: systerapb.Systera.Dispatch:output_type -> systerapb.Empty
-	7,  // 66: systerapb.Systera.Chat:output_type -> systerapb.Empty
-	14, // 67: systerapb.Systera.AddChatIgnore:output_type -> systerapb.ChatIgnoreResponse
-	14, // 68: systerapb.Systera.RemoveChatIgnore:output_type -> systerapb.ChatIgnoreResponse
-	23, // 69: systerapb.Systera.GetPlayerIdentityByName:output_type -> systerapb.GetPlayerIdentityByNameResponse
-	27, // 70: systerapb.Systera.InitPlayerProfile:output_type -> systerapb.InitPlayerProfileResponse
-	30, // 71: systerapb.Systera.FetchPlayerProfile:output_type -> systerapb.FetchPlayerProfileResponse
-	30, // 72: systerapb.Systera.FetchPlayerProfileByName:output_type -> systerapb.FetchPlayerProfileResponse
-	7,  // 73: systerapb.Systera.SetPlayerGroups:output_type -> systerapb.Empty
-	7,  // 74: systerapb.Systera.SetPlayerServer:output_type -> systerapb.Empty
-	7,  // 75: systerapb.Systera.RemovePlayerServer:output_type -> systerapb.Empty
-	7,  // 76: systerapb.Systera.SetPlayerSettings:output_type -> systerapb.Empty
-	38, // 77: systerapb.Systera.AltLookup:output_type -> systerapb.AltLookupResponse
-	41, // 78: systerapb.Systera.GetPlayerPunish:output_type -> systerapb.GetPlayerPunishResponse
-	43, // 79: systerapb.Systera.SetPlayerPunish:output_type -> systerapb.SetPlayerPunishResponse
-	45, // 80: systerapb.Systera.UnBan:output_type -> systerapb.UnBanResponse
-	48, // 81: systerapb.Systera.Report:output_type -> systerapb.ReportResponse
-	52, // 82: systerapb.Systera.FetchGroups:output_type -> systerapb.FetchGroupsResponse
-	7,  // 83: systerapb.Systera.CreateGroup:output_type -> systerapb.Empty
-	7,  // 84: systerapb.Systera.RemoveGroup:output_type -> systerapb.Empty
-	7,  // 85: systerapb.Systera.UpdateGroup:output_type -> systerapb.Empty
-	7,  // 86: systerapb.Systera.AddPermission:output_type -> systerapb.Empty
-	7,  // 87: systerapb.Systera.RemovePermission:output_type -> systerapb.Empty
-	64, // [64:88] is the sub-list for method output_type
-	40, // [40:64] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+
+func (x *InitPlayerProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_systera_proto_init() }
-func file_systera_proto_init() {
-	if File_systera_proto != nil {
-		return
+func (*InitPlayerProfileRequest) ProtoMessage() {}
+
+func (x *InitPlayerProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_systera_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitPlayerProfileRequest.ProtoReflect.Descriptor instead.
+func (*InitPlayerProfileRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *InitPlayerProfileRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *InitPlayerProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InitPlayerProfileRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *InitPlayerProfileRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+type InitPlayerProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *PlayerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// first_join - profile was created by this login
+	FirstJoin bool `protobuf:"varint,2,opt,name=first_join,json=firstJoin,proto3" json:"first_join,omitempty"`
+	// name_changed - name differs from previous login (previous_name)
+	NameChanged  bool   `protobuf:"varint,3,opt,name=name_changed,json=nameChanged,proto3" json:"name_changed,omitempty"`
+	PreviousName string `protobuf:"bytes,4,opt,name=previous_name,json=previousName,proto3" json:"previous_name,omitempty"`
+	// ban - active ban verdict (UNKNOWN: not banned, TEMPBAN or PERMBAN)
+	Ban PunishLevel `protobuf:"varint,5,opt,name=ban,proto3,enum=systerapb.PunishLevel" json:"ban,omitempty"`
+	// active_ban - punishment blocking login (reason, expire, punished_from)
+	ActiveBan *PunishEntry `protobuf:"bytes,6,opt,name=active_ban,json=activeBan,proto3" json:"active_ban,omitempty"`
+	// kick_message - rendered kick message for active_ban (empty if not banned)
+	KickMessage string `protobuf:"bytes,7,opt,name=kick_message,json=kickMessage,proto3" json:"kick_message,omitempty"`
+}
+
+func (x *InitPlayerProfileResponse) Reset() {
+	*x = InitPlayerProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitPlayerProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitPlayerProfileResponse) ProtoMessage() {}
+
+func (x *InitPlayerProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitPlayerProfileResponse.ProtoReflect.Descriptor instead.
+func (*InitPlayerProfileResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *InitPlayerProfileResponse) GetEntry() *PlayerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *InitPlayerProfileResponse) GetFirstJoin() bool {
+	if x != nil {
+		return x.FirstJoin
+	}
+	return false
+}
+
+func (x *InitPlayerProfileResponse) GetNameChanged() bool {
+	if x != nil {
+		return x.NameChanged
+	}
+	return false
+}
+
+func (x *InitPlayerProfileResponse) GetPreviousName() string {
+	if x != nil {
+		return x.PreviousName
+	}
+	return ""
+}
+
+func (x *InitPlayerProfileResponse) GetBan() PunishLevel {
+	if x != nil {
+		return x.Ban
+	}
+	return PunishLevel_UNKNOWN
+}
+
+func (x *InitPlayerProfileResponse) GetActiveBan() *PunishEntry {
+	if x != nil {
+		return x.ActiveBan
+	}
+	return nil
+}
+
+func (x *InitPlayerProfileResponse) GetKickMessage() string {
+	if x != nil {
+		return x.KickMessage
+	}
+	return ""
+}
+
+type FetchPlayerProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *FetchPlayerProfileRequest) Reset() {
+	*x = FetchPlayerProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchPlayerProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchPlayerProfileRequest) ProtoMessage() {}
+
+func (x *FetchPlayerProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchPlayerProfileRequest.ProtoReflect.Descriptor instead.
+func (*FetchPlayerProfileRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FetchPlayerProfileRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type FetchPlayerProfileByNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *FetchPlayerProfileByNameRequest) Reset() {
+	*x = FetchPlayerProfileByNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchPlayerProfileByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchPlayerProfileByNameRequest) ProtoMessage() {}
+
+func (x *FetchPlayerProfileByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchPlayerProfileByNameRequest.ProtoReflect.Descriptor instead.
+func (*FetchPlayerProfileByNameRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FetchPlayerProfileByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FetchPlayerProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *PlayerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// staff_note_count - active staff notes (staff scope only, 0 otherwise)
+	StaffNoteCount int32 `protobuf:"varint,2,opt,name=staff_note_count,json=staffNoteCount,proto3" json:"staff_note_count,omitempty"`
+}
+
+func (x *FetchPlayerProfileResponse) Reset() {
+	*x = FetchPlayerProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchPlayerProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchPlayerProfileResponse) ProtoMessage() {}
+
+func (x *FetchPlayerProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchPlayerProfileResponse.ProtoReflect.Descriptor instead.
+func (*FetchPlayerProfileResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FetchPlayerProfileResponse) GetEntry() *PlayerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *FetchPlayerProfileResponse) GetStaffNoteCount() int32 {
+	if x != nil {
+		return x.StaffNoteCount
+	}
+	return 0
+}
+
+type SetPlayerGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid   string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *SetPlayerGroupsRequest) Reset() {
+	*x = SetPlayerGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlayerGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerGroupsRequest) ProtoMessage() {}
+
+func (x *SetPlayerGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerGroupsRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetPlayerGroupsRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SetPlayerGroupsRequest) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type SetPlayerServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid       string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	ServerName string `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+}
+
+func (x *SetPlayerServerRequest) Reset() {
+	*x = SetPlayerServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlayerServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerServerRequest) ProtoMessage() {}
+
+func (x *SetPlayerServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerServerRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerServerRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetPlayerServerRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SetPlayerServerRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+type RemovePlayerServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid       string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	ServerName string `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+}
+
+func (x *RemovePlayerServerRequest) Reset() {
+	*x = RemovePlayerServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemovePlayerServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePlayerServerRequest) ProtoMessage() {}
+
+func (x *RemovePlayerServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePlayerServerRequest.ProtoReflect.Descriptor instead.
+func (*RemovePlayerServerRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RemovePlayerServerRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *RemovePlayerServerRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+// Heartbeat - renew presence of players on server
+// (players without renewal are removed from server after ttl)
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName string   `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Uuids      []string `protobuf:"bytes,2,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// ttl - presence lease (millis, 0: default)
+	Ttl int64 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *HeartbeatRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *HeartbeatRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *HeartbeatRequest) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ListOnlinePlayersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// server_name - filter by server (empty: all servers)
+	ServerName string `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+}
+
+func (x *ListOnlinePlayersRequest) Reset() {
+	*x = ListOnlinePlayersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOnlinePlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnlinePlayersRequest) ProtoMessage() {}
+
+func (x *ListOnlinePlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnlinePlayersRequest.ProtoReflect.Descriptor instead.
+func (*ListOnlinePlayersRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListOnlinePlayersRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+type OnlinePlayerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identity   *PlayerIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	ServerName string          `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+}
+
+func (x *OnlinePlayerEntry) Reset() {
+	*x = OnlinePlayerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OnlinePlayerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnlinePlayerEntry) ProtoMessage() {}
+
+func (x *OnlinePlayerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnlinePlayerEntry.ProtoReflect.Descriptor instead.
+func (*OnlinePlayerEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *OnlinePlayerEntry) GetIdentity() *PlayerIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *OnlinePlayerEntry) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+type ListOnlinePlayersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*OnlinePlayerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListOnlinePlayersResponse) Reset() {
+	*x = ListOnlinePlayersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOnlinePlayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnlinePlayersResponse) ProtoMessage() {}
+
+func (x *ListOnlinePlayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnlinePlayersResponse.ProtoReflect.Descriptor instead.
+func (*ListOnlinePlayersResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListOnlinePlayersResponse) GetEntries() []*OnlinePlayerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type NameHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FirstSeen int64  `protobuf:"varint,2,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastUsed  int64  `protobuf:"varint,3,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
+	// released_at - name was taken by another player (0: not released)
+	ReleasedAt int64 `protobuf:"varint,4,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+}
+
+func (x *NameHistoryEntry) Reset() {
+	*x = NameHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NameHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameHistoryEntry) ProtoMessage() {}
+
+func (x *NameHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameHistoryEntry.ProtoReflect.Descriptor instead.
+func (*NameHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *NameHistoryEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NameHistoryEntry) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *NameHistoryEntry) GetLastUsed() int64 {
+	if x != nil {
+		return x.LastUsed
+	}
+	return 0
+}
+
+func (x *NameHistoryEntry) GetReleasedAt() int64 {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return 0
+}
+
+type GetNameHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetNameHistoryRequest) Reset() {
+	*x = GetNameHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNameHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNameHistoryRequest) ProtoMessage() {}
+
+func (x *GetNameHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNameHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetNameHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetNameHistoryRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// GetNameHistoryResponse - newest first
+type GetNameHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*NameHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetNameHistoryResponse) Reset() {
+	*x = GetNameHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNameHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNameHistoryResponse) ProtoMessage() {}
+
+func (x *GetNameHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNameHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetNameHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetNameHistoryResponse) GetEntries() []*NameHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Playtime (millis) / Session
+type ServerPlaytime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName string `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Playtime   int64  `protobuf:"varint,2,opt,name=playtime,proto3" json:"playtime,omitempty"`
+}
+
+func (x *ServerPlaytime) Reset() {
+	*x = ServerPlaytime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerPlaytime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerPlaytime) ProtoMessage() {}
+
+func (x *ServerPlaytime) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerPlaytime.ProtoReflect.Descriptor instead.
+func (*ServerPlaytime) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ServerPlaytime) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ServerPlaytime) GetPlaytime() int64 {
+	if x != nil {
+		return x.Playtime
+	}
+	return 0
+}
+
+type GetPlaytimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *GetPlaytimeRequest) Reset() {
+	*x = GetPlaytimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaytimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaytimeRequest) ProtoMessage() {}
+
+func (x *GetPlaytimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaytimeRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaytimeRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetPlaytimeRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type GetPlaytimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// total - including current session
+	Total   int64             `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Servers []*ServerPlaytime `protobuf:"bytes,2,rep,name=servers,proto3" json:"servers,omitempty"`
+}
+
+func (x *GetPlaytimeResponse) Reset() {
+	*x = GetPlaytimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaytimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaytimeResponse) ProtoMessage() {}
+
+func (x *GetPlaytimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaytimeResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaytimeResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetPlaytimeResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetPlaytimeResponse) GetServers() []*ServerPlaytime {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type SessionEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName string `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	JoinedAt   int64  `protobuf:"varint,2,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	// left_at - 0 while playing
+	LeftAt   int64 `protobuf:"varint,3,opt,name=left_at,json=leftAt,proto3" json:"left_at,omitempty"`
+	Duration int64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *SessionEntry) Reset() {
+	*x = SessionEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEntry) ProtoMessage() {}
+
+func (x *SessionEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEntry.ProtoReflect.Descriptor instead.
+func (*SessionEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SessionEntry) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *SessionEntry) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+func (x *SessionEntry) GetLeftAt() int64 {
+	if x != nil {
+		return x.LeftAt
+	}
+	return 0
+}
+
+func (x *SessionEntry) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type GetSessionHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// server_name - filter by server (empty: all servers)
+	ServerName string `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// limit - max entries, newest first (0: 50, max 500)
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetSessionHistoryRequest) Reset() {
+	*x = GetSessionHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionHistoryRequest) ProtoMessage() {}
+
+func (x *GetSessionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetSessionHistoryRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetSessionHistoryRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *GetSessionHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSessionHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*SessionEntry `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *GetSessionHistoryResponse) Reset() {
+	*x = GetSessionHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionHistoryResponse) ProtoMessage() {}
+
+func (x *GetSessionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetSessionHistoryResponse) GetSessions() []*SessionEntry {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type PlaytimeEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identity *PlayerIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Playtime int64           `protobuf:"varint,2,opt,name=playtime,proto3" json:"playtime,omitempty"`
+}
+
+func (x *PlaytimeEntry) Reset() {
+	*x = PlaytimeEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaytimeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaytimeEntry) ProtoMessage() {}
+
+func (x *PlaytimeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaytimeEntry.ProtoReflect.Descriptor instead.
+func (*PlaytimeEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PlaytimeEntry) GetIdentity() *PlayerIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *PlaytimeEntry) GetPlaytime() int64 {
+	if x != nil {
+		return x.Playtime
+	}
+	return 0
+}
+
+// GetPlaytimeLeaderboard - ranking by finished sessions
+type GetPlaytimeLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// server_name - filter by server (empty: all servers)
+	ServerName string `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// since - sessions joined after (millis, 0: all time)
+	Since int64 `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	// limit - max entries (0: 10, max 500)
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetPlaytimeLeaderboardRequest) Reset() {
+	*x = GetPlaytimeLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaytimeLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaytimeLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetPlaytimeLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaytimeLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaytimeLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetPlaytimeLeaderboardRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *GetPlaytimeLeaderboardRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *GetPlaytimeLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetPlaytimeLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*PlaytimeEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetPlaytimeLeaderboardResponse) Reset() {
+	*x = GetPlaytimeLeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaytimeLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaytimeLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetPlaytimeLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaytimeLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaytimeLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetPlaytimeLeaderboardResponse) GetEntries() []*PlaytimeEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type SetPlayerSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid     string          `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Settings *PlayerSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *SetPlayerSettingsRequest) Reset() {
+	*x = SetPlayerSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlayerSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerSettingsRequest) ProtoMessage() {}
+
+func (x *SetPlayerSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetPlayerSettingsRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SetPlayerSettingsRequest) GetSettings() *PlayerSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// ALTLOOKUP
+type AddressesEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Hostname  string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	FirstSeen int64  `protobuf:"varint,3,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen  int64  `protobuf:"varint,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+}
+
+func (x *AddressesEntry) Reset() {
+	*x = AddressesEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressesEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressesEntry) ProtoMessage() {}
+
+func (x *AddressesEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressesEntry.ProtoReflect.Descriptor instead.
+func (*AddressesEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AddressesEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressesEntry) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *AddressesEntry) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *AddressesEntry) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+type AltLookupEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid      string            `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name      string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Addresses []*AddressesEntry `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *AltLookupEntry) Reset() {
+	*x = AltLookupEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AltLookupEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AltLookupEntry) ProtoMessage() {}
+
+func (x *AltLookupEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AltLookupEntry.ProtoReflect.Descriptor instead.
+func (*AltLookupEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AltLookupEntry) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *AltLookupEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AltLookupEntry) GetAddresses() []*AddressesEntry {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type AltLookupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUuid string `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+}
+
+func (x *AltLookupRequest) Reset() {
+	*x = AltLookupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AltLookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AltLookupRequest) ProtoMessage() {}
+
+func (x *AltLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AltLookupRequest.ProtoReflect.Descriptor instead.
+func (*AltLookupRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AltLookupRequest) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+type AltLookupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AltLookupEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *AltLookupResponse) Reset() {
+	*x = AltLookupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AltLookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AltLookupResponse) ProtoMessage() {}
+
+func (x *AltLookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AltLookupResponse.ProtoReflect.Descriptor instead.
+func (*AltLookupResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AltLookupResponse) GetEntries() []*AltLookupEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PlayerNote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid      string          `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Message   string          `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Severity  NoteSeverity    `protobuf:"varint,4,opt,name=severity,proto3,enum=systerapb.NoteSeverity" json:"severity,omitempty"`
+	Author    *PlayerIdentity `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt int64           `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// expires_at - expiry (millis, 0: never)
+	ExpiresAt int64 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *PlayerNote) Reset() {
+	*x = PlayerNote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerNote) ProtoMessage() {}
+
+func (x *PlayerNote) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerNote.ProtoReflect.Descriptor instead.
+func (*PlayerNote) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PlayerNote) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlayerNote) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PlayerNote) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlayerNote) GetSeverity() NoteSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return NoteSeverity_NOTE_INFO
+}
+
+func (x *PlayerNote) GetAuthor() *PlayerIdentity {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *PlayerNote) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PlayerNote) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type AddPlayerNoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *PlayerNote `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *AddPlayerNoteRequest) Reset() {
+	*x = AddPlayerNoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPlayerNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlayerNoteRequest) ProtoMessage() {}
+
+func (x *AddPlayerNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlayerNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddPlayerNoteRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AddPlayerNoteRequest) GetEntry() *PlayerNote {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type AddPlayerNoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *PlayerNote `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *AddPlayerNoteResponse) Reset() {
+	*x = AddPlayerNoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPlayerNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlayerNoteResponse) ProtoMessage() {}
+
+func (x *AddPlayerNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlayerNoteResponse.ProtoReflect.Descriptor instead.
+func (*AddPlayerNoteResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AddPlayerNoteResponse) GetEntry() *PlayerNote {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ListPlayerNotesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// include_expired - include expired notes
+	IncludeExpired bool `protobuf:"varint,2,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+}
+
+func (x *ListPlayerNotesRequest) Reset() {
+	*x = ListPlayerNotesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlayerNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerNotesRequest) ProtoMessage() {}
+
+func (x *ListPlayerNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListPlayerNotesRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListPlayerNotesRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ListPlayerNotesRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListPlayerNotesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*PlayerNote `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListPlayerNotesResponse) Reset() {
+	*x = ListPlayerNotesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlayerNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerNotesResponse) ProtoMessage() {}
+
+func (x *ListPlayerNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListPlayerNotesResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListPlayerNotesResponse) GetEntries() []*PlayerNote {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type DeletePlayerNoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeletePlayerNoteRequest) Reset() {
+	*x = DeletePlayerNoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePlayerNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlayerNoteRequest) ProtoMessage() {}
+
+func (x *DeletePlayerNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlayerNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlayerNoteRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeletePlayerNoteRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PunishEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Available    bool            `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Level        PunishLevel     `protobuf:"varint,2,opt,name=level,proto3,enum=systerapb.PunishLevel" json:"level,omitempty"`
+	Reason       string          `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Date         int64           `protobuf:"varint,4,opt,name=date,proto3" json:"date,omitempty"`
+	Expire       int64           `protobuf:"varint,5,opt,name=expire,proto3" json:"expire,omitempty"`
+	PunishedFrom *PlayerIdentity `protobuf:"bytes,6,opt,name=punished_from,json=punishedFrom,proto3" json:"punished_from,omitempty"`
+	PunishedTo   *PlayerIdentity `protobuf:"bytes,7,opt,name=punished_to,json=punishedTo,proto3" json:"punished_to,omitempty"`
+	// id - punishment ID (SubmitAppealRequest.punishment_id)
+	Id       uint32           `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
+	Evidence []*EvidenceEntry `protobuf:"bytes,9,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *PunishEntry) Reset() {
+	*x = PunishEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PunishEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PunishEntry) ProtoMessage() {}
+
+func (x *PunishEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PunishEntry.ProtoReflect.Descriptor instead.
+func (*PunishEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PunishEntry) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *PunishEntry) GetLevel() PunishLevel {
+	if x != nil {
+		return x.Level
+	}
+	return PunishLevel_UNKNOWN
+}
+
+func (x *PunishEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PunishEntry) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *PunishEntry) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+func (x *PunishEntry) GetPunishedFrom() *PlayerIdentity {
+	if x != nil {
+		return x.PunishedFrom
+	}
+	return nil
+}
+
+func (x *PunishEntry) GetPunishedTo() *PlayerIdentity {
+	if x != nil {
+		return x.PunishedTo
+	}
+	return nil
+}
+
+func (x *PunishEntry) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PunishEntry) GetEvidence() []*EvidenceEntry {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+type GetPlayerPunishRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid           string      `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	FilterLevel    PunishLevel `protobuf:"varint,2,opt,name=filter_level,json=filterLevel,proto3,enum=systerapb.PunishLevel" json:"filter_level,omitempty"`
+	IncludeExpired bool        `protobuf:"varint,3,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+}
+
+func (x *GetPlayerPunishRequest) Reset() {
+	*x = GetPlayerPunishRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerPunishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerPunishRequest) ProtoMessage() {}
+
+func (x *GetPlayerPunishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerPunishRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerPunishRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetPlayerPunishRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetPlayerPunishRequest) GetFilterLevel() PunishLevel {
+	if x != nil {
+		return x.FilterLevel
+	}
+	return PunishLevel_UNKNOWN
+}
+
+func (x *GetPlayerPunishRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type GetPlayerPunishResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry []*PunishEntry `protobuf:"bytes,1,rep,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *GetPlayerPunishResponse) Reset() {
+	*x = GetPlayerPunishResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerPunishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerPunishResponse) ProtoMessage() {}
+
+func (x *GetPlayerPunishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerPunishResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerPunishResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetPlayerPunishResponse) GetEntry() []*PunishEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type SetPlayerPunishRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// remote - use with stream?
+	Remote bool `protobuf:"varint,1,opt,name=remote,proto3" json:"remote,omitempty"`
+	// force - force punish? (without InitPlayerProfile phase)
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// entry - Punishment details
+	Entry *PunishEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *SetPlayerPunishRequest) Reset() {
+	*x = SetPlayerPunishRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlayerPunishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerPunishRequest) ProtoMessage() {}
+
+func (x *SetPlayerPunishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerPunishRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerPunishRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SetPlayerPunishRequest) GetRemote() bool {
+	if x != nil {
+		return x.Remote
+	}
+	return false
+}
+
+func (x *SetPlayerPunishRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *SetPlayerPunishRequest) GetEntry() *PunishEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type SetPlayerPunishResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NoProfile bool `protobuf:"varint,1,opt,name=no_profile,json=noProfile,proto3" json:"no_profile,omitempty"`
+	Offline   bool `protobuf:"varint,2,opt,name=offline,proto3" json:"offline,omitempty"`
+	Duplicate bool `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	Cooldown  bool `protobuf:"varint,4,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+}
+
+func (x *SetPlayerPunishResponse) Reset() {
+	*x = SetPlayerPunishResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlayerPunishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerPunishResponse) ProtoMessage() {}
+
+func (x *SetPlayerPunishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerPunishResponse.ProtoReflect.Descriptor instead.
+func (*SetPlayerPunishResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SetPlayerPunishResponse) GetNoProfile() bool {
+	if x != nil {
+		return x.NoProfile
+	}
+	return false
+}
+
+func (x *SetPlayerPunishResponse) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+func (x *SetPlayerPunishResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *SetPlayerPunishResponse) GetCooldown() bool {
+	if x != nil {
+		return x.Cooldown
+	}
+	return false
+}
+
+type UnBanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Target *PlayerIdentity `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *UnBanRequest) Reset() {
+	*x = UnBanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnBanRequest) ProtoMessage() {}
+
+func (x *UnBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnBanRequest.ProtoReflect.Descriptor instead.
+func (*UnBanRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UnBanRequest) GetTarget() *PlayerIdentity {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type UnBanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnBanResponse) Reset() {
+	*x = UnBanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnBanResponse) ProtoMessage() {}
+
+func (x *UnBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnBanResponse.ProtoReflect.Descriptor instead.
+func (*UnBanResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{92}
+}
+
+type SearchPunishmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from / to - punished date range [from, to) (millis, 0: unbounded)
+	From int64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	// punisher - punisher uuid or name (empty: any)
+	Punisher string `protobuf:"bytes,3,opt,name=punisher,proto3" json:"punisher,omitempty"`
+	// level - exact level (UNKNOWN: any)
+	Level PunishLevel `protobuf:"varint,4,opt,name=level,proto3,enum=systerapb.PunishLevel" json:"level,omitempty"`
+	// keyword - substring of reason (case-insensitive)
+	Keyword string `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	// active_only - exclude expired / revoked punishments
+	ActiveOnly bool `protobuf:"varint,6,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	// cursor - next_cursor of previous page (empty: first page)
+	Cursor string `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// limit - page size (0: 50, max 500)
+	Limit int32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchPunishmentsRequest) Reset() {
+	*x = SearchPunishmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPunishmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPunishmentsRequest) ProtoMessage() {}
+
+func (x *SearchPunishmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPunishmentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPunishmentsRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SearchPunishmentsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *SearchPunishmentsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *SearchPunishmentsRequest) GetPunisher() string {
+	if x != nil {
+		return x.Punisher
+	}
+	return ""
+}
+
+func (x *SearchPunishmentsRequest) GetLevel() PunishLevel {
+	if x != nil {
+		return x.Level
+	}
+	return PunishLevel_UNKNOWN
+}
+
+func (x *SearchPunishmentsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchPunishmentsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *SearchPunishmentsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchPunishmentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PunishLevelCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level PunishLevel `protobuf:"varint,1,opt,name=level,proto3,enum=systerapb.PunishLevel" json:"level,omitempty"`
+	Count int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PunishLevelCount) Reset() {
+	*x = PunishLevelCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PunishLevelCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PunishLevelCount) ProtoMessage() {}
+
+func (x *PunishLevelCount) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PunishLevelCount.ProtoReflect.Descriptor instead.
+func (*PunishLevelCount) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PunishLevelCount) GetLevel() PunishLevel {
+	if x != nil {
+		return x.Level
+	}
+	return PunishLevel_UNKNOWN
+}
+
+func (x *PunishLevelCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SearchPunishmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// entries - newest first
+	Entries []*PunishEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// next_cursor - cursor of next page (empty: last page)
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// totals - matches of all pages per level (level filter is ignored)
+	Totals []*PunishLevelCount `protobuf:"bytes,3,rep,name=totals,proto3" json:"totals,omitempty"`
+}
+
+func (x *SearchPunishmentsResponse) Reset() {
+	*x = SearchPunishmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchPunishmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPunishmentsResponse) ProtoMessage() {}
+
+func (x *SearchPunishmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPunishmentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPunishmentsResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *SearchPunishmentsResponse) GetEntries() []*PunishEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *SearchPunishmentsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *SearchPunishmentsResponse) GetTotals() []*PunishLevelCount {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type AppealEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	State      AppealState  `protobuf:"varint,2,opt,name=state,proto3,enum=systerapb.AppealState" json:"state,omitempty"`
+	Punishment *PunishEntry `protobuf:"bytes,3,opt,name=punishment,proto3" json:"punishment,omitempty"`
+	// from - appellant (punished player)
+	From       *PlayerIdentity `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	Message    string          `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Date       int64           `protobuf:"varint,6,opt,name=date,proto3" json:"date,omitempty"`
+	Reviewer   *PlayerIdentity `protobuf:"bytes,7,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	ReviewNote string          `protobuf:"bytes,8,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	// reviewed_at - review time (millis, 0: not reviewed)
+	ReviewedAt int64 `protobuf:"varint,9,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+}
+
+func (x *AppealEntry) Reset() {
+	*x = AppealEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppealEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealEntry) ProtoMessage() {}
+
+func (x *AppealEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealEntry.ProtoReflect.Descriptor instead.
+func (*AppealEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *AppealEntry) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AppealEntry) GetState() AppealState {
+	if x != nil {
+		return x.State
+	}
+	return AppealState_APPEAL_ANY
+}
+
+func (x *AppealEntry) GetPunishment() *PunishEntry {
+	if x != nil {
+		return x.Punishment
+	}
+	return nil
+}
+
+func (x *AppealEntry) GetFrom() *PlayerIdentity {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AppealEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AppealEntry) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *AppealEntry) GetReviewer() *PlayerIdentity {
+	if x != nil {
+		return x.Reviewer
+	}
+	return nil
+}
+
+func (x *AppealEntry) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *AppealEntry) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+type SubmitAppealRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PunishmentId uint32          `protobuf:"varint,1,opt,name=punishment_id,json=punishmentId,proto3" json:"punishment_id,omitempty"`
+	From         *PlayerIdentity `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Message      string          `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SubmitAppealRequest) Reset() {
+	*x = SubmitAppealRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAppealRequest) ProtoMessage() {}
+
+func (x *SubmitAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAppealRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAppealRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SubmitAppealRequest) GetPunishmentId() uint32 {
+	if x != nil {
+		return x.PunishmentId
+	}
+	return 0
+}
+
+func (x *SubmitAppealRequest) GetFrom() *PlayerIdentity {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SubmitAppealRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SubmitAppealResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *AppealEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *SubmitAppealResponse) Reset() {
+	*x = SubmitAppealResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAppealResponse) ProtoMessage() {}
+
+func (x *SubmitAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAppealResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAppealResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SubmitAppealResponse) GetEntry() *AppealEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ListAppealsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// state - filter by state (APPEAL_ANY: all)
+	State AppealState `protobuf:"varint,1,opt,name=state,proto3,enum=systerapb.AppealState" json:"state,omitempty"`
+	// uuid - filter by appellant (empty: all players)
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// limit - max entries (0: 50, max 500)
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListAppealsRequest) Reset() {
+	*x = ListAppealsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAppealsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppealsRequest) ProtoMessage() {}
+
+func (x *ListAppealsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppealsRequest.ProtoReflect.Descriptor instead.
+func (*ListAppealsRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListAppealsRequest) GetState() AppealState {
+	if x != nil {
+		return x.State
+	}
+	return AppealState_APPEAL_ANY
+}
+
+func (x *ListAppealsRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ListAppealsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAppealsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AppealEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListAppealsResponse) Reset() {
+	*x = ListAppealsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAppealsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAppealsResponse) ProtoMessage() {}
+
+func (x *ListAppealsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAppealsResponse.ProtoReflect.Descriptor instead.
+func (*ListAppealsResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListAppealsResponse) GetEntries() []*AppealEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ReviewAppealRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reviewer *PlayerIdentity `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	// accept - accept (revoke punishment) or deny
+	Accept bool   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	Note   string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *ReviewAppealRequest) Reset() {
+	*x = ReviewAppealRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAppealRequest) ProtoMessage() {}
+
+func (x *ReviewAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAppealRequest.ProtoReflect.Descriptor instead.
+func (*ReviewAppealRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ReviewAppealRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewAppealRequest) GetReviewer() *PlayerIdentity {
+	if x != nil {
+		return x.Reviewer
+	}
+	return nil
+}
+
+func (x *ReviewAppealRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+func (x *ReviewAppealRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReviewAppealResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *AppealEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *ReviewAppealResponse) Reset() {
+	*x = ReviewAppealResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAppealResponse) ProtoMessage() {}
+
+func (x *ReviewAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAppealResponse.ProtoReflect.Descriptor instead.
+func (*ReviewAppealResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ReviewAppealResponse) GetEntry() *AppealEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ReportEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From     *PlayerIdentity  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To       *PlayerIdentity  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Message  string           `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Date     int64            `protobuf:"varint,4,opt,name=date,proto3" json:"date,omitempty"`
+	Server   string           `protobuf:"bytes,5,opt,name=server,proto3" json:"server,omitempty"`
+	Id       uint32           `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+	Evidence []*EvidenceEntry `protobuf:"bytes,7,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *ReportEntry) Reset() {
+	*x = ReportEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportEntry) ProtoMessage() {}
+
+func (x *ReportEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportEntry.ProtoReflect.Descriptor instead.
+func (*ReportEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ReportEntry) GetFrom() *PlayerIdentity {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReportEntry) GetTo() *PlayerIdentity {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ReportEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReportEntry) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *ReportEntry) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *ReportEntry) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReportEntry) GetEvidence() []*EvidenceEntry {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+type ReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From       *PlayerIdentity `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To         *PlayerIdentity `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	ServerName string          `protobuf:"bytes,3,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Message    string          `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ReportRequest) Reset() {
+	*x = ReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRequest) ProtoMessage() {}
+
+func (x *ReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
+func (*ReportRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ReportRequest) GetFrom() *PlayerIdentity {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ReportRequest) GetTo() *PlayerIdentity {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ReportRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *ReportEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *ReportResponse) Reset() {
+	*x = ReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportResponse) ProtoMessage() {}
+
+func (x *ReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportResponse.ProtoReflect.Descriptor instead.
+func (*ReportResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ReportResponse) GetEntry() *ReportEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uuid - reported player (empty: all players)
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// limit - max entries, newest first (0: 50, max 500)
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetReportsRequest) Reset() {
+	*x = GetReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportsRequest) ProtoMessage() {}
+
+func (x *GetReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportsRequest.ProtoReflect.Descriptor instead.
+func (*GetReportsRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetReportsRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GetReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry []*ReportEntry `protobuf:"bytes,1,rep,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *GetReportsResponse) Reset() {
+	*x = GetReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportsResponse) ProtoMessage() {}
+
+func (x *GetReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportsResponse.ProtoReflect.Descriptor instead.
+func (*GetReportsResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetReportsResponse) GetEntry() []*ReportEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// EVIDENCE
+type EvidenceEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// punishment_id / report_id - attached to (either one)
+	PunishmentId uint32          `protobuf:"varint,2,opt,name=punishment_id,json=punishmentId,proto3" json:"punishment_id,omitempty"`
+	ReportId     uint32          `protobuf:"varint,3,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Url          string          `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Uploader     *PlayerIdentity `protobuf:"bytes,5,opt,name=uploader,proto3" json:"uploader,omitempty"`
+	Note         string          `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	Date         int64           `protobuf:"varint,7,opt,name=date,proto3" json:"date,omitempty"`
+	// blob_size - size of attached blob (0: none, fetch with GetEvidenceBlob)
+	BlobSize    int64  `protobuf:"varint,8,opt,name=blob_size,json=blobSize,proto3" json:"blob_size,omitempty"`
+	ContentType string `protobuf:"bytes,9,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+}
+
+func (x *EvidenceEntry) Reset() {
+	*x = EvidenceEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvidenceEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvidenceEntry) ProtoMessage() {}
+
+func (x *EvidenceEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvidenceEntry.ProtoReflect.Descriptor instead.
+func (*EvidenceEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *EvidenceEntry) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EvidenceEntry) GetPunishmentId() uint32 {
+	if x != nil {
+		return x.PunishmentId
+	}
+	return 0
+}
+
+func (x *EvidenceEntry) GetReportId() uint32 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *EvidenceEntry) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EvidenceEntry) GetUploader() *PlayerIdentity {
+	if x != nil {
+		return x.Uploader
+	}
+	return nil
+}
+
+func (x *EvidenceEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *EvidenceEntry) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *EvidenceEntry) GetBlobSize() int64 {
+	if x != nil {
+		return x.BlobSize
+	}
+	return 0
+}
+
+func (x *EvidenceEntry) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type AddEvidenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *EvidenceEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// blob - optional attachment (ex. screenshot, up to 1 MiB)
+	Blob []byte `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+}
+
+func (x *AddEvidenceRequest) Reset() {
+	*x = AddEvidenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEvidenceRequest) ProtoMessage() {}
+
+func (x *AddEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*AddEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AddEvidenceRequest) GetEntry() *EvidenceEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *AddEvidenceRequest) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+type AddEvidenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *EvidenceEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *AddEvidenceResponse) Reset() {
+	*x = AddEvidenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEvidenceResponse) ProtoMessage() {}
+
+func (x *AddEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*AddEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AddEvidenceResponse) GetEntry() *EvidenceEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetEvidenceBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetEvidenceBlobRequest) Reset() {
+	*x = GetEvidenceBlobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvidenceBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvidenceBlobRequest) ProtoMessage() {}
+
+func (x *GetEvidenceBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvidenceBlobRequest.ProtoReflect.Descriptor instead.
+func (*GetEvidenceBlobRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetEvidenceBlobRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEvidenceBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *EvidenceEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Blob  []byte         `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+}
+
+func (x *GetEvidenceBlobResponse) Reset() {
+	*x = GetEvidenceBlobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvidenceBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvidenceBlobResponse) ProtoMessage() {}
+
+func (x *GetEvidenceBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvidenceBlobResponse.ProtoReflect.Descriptor instead.
+func (*GetEvidenceBlobResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetEvidenceBlobResponse) GetEntry() *EvidenceEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *GetEvidenceBlobResponse) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+// GROUP PERMISISONS
+type GroupEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName   string              `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	GroupPrefix string              `protobuf:"bytes,2,opt,name=group_prefix,json=groupPrefix,proto3" json:"group_prefix,omitempty"`
+	Permissions []*PermissionsEntry `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *GroupEntry) Reset() {
+	*x = GroupEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupEntry) ProtoMessage() {}
+
+func (x *GroupEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupEntry.ProtoReflect.Descriptor instead.
+func (*GroupEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GroupEntry) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *GroupEntry) GetGroupPrefix() string {
+	if x != nil {
+		return x.GroupPrefix
+	}
+	return ""
+}
+
+func (x *GroupEntry) GetPermissions() []*PermissionsEntry {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type PermissionsEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerName  string   `protobuf:"bytes,1,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *PermissionsEntry) Reset() {
+	*x = PermissionsEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionsEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionsEntry) ProtoMessage() {}
+
+func (x *PermissionsEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionsEntry.ProtoReflect.Descriptor instead.
+func (*PermissionsEntry) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *PermissionsEntry) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *PermissionsEntry) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type FetchGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FetchGroupsRequest) Reset() {
+	*x = FetchGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchGroupsRequest) ProtoMessage() {}
+
+func (x *FetchGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchGroupsRequest.ProtoReflect.Descriptor instead.
+func (*FetchGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{115}
+}
+
+type FetchGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*GroupEntry `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *FetchGroupsResponse) Reset() {
+	*x = FetchGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchGroupsResponse) ProtoMessage() {}
+
+func (x *FetchGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchGroupsResponse.ProtoReflect.Descriptor instead.
+func (*FetchGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *FetchGroupsResponse) GetGroups() []*GroupEntry {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupEntry *GroupEntry `protobuf:"bytes,1,opt,name=group_entry,json=groupEntry,proto3" json:"group_entry,omitempty"`
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CreateGroupRequest) GetGroupEntry() *GroupEntry {
+	if x != nil {
+		return x.GroupEntry
+	}
+	return nil
+}
+
+type RemoveGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+}
+
+func (x *RemoveGroupRequest) Reset() {
+	*x = RemoveGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupRequest) ProtoMessage() {}
+
+func (x *RemoveGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *RemoveGroupRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type UpdateGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupEntry *GroupEntry `protobuf:"bytes,1,opt,name=group_entry,json=groupEntry,proto3" json:"group_entry,omitempty"`
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *UpdateGroupRequest) GetGroupEntry() *GroupEntry {
+	if x != nil {
+		return x.GroupEntry
+	}
+	return nil
+}
+
+type AddPermissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName   string   `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Target      string   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *AddPermissionRequest) Reset() {
+	*x = AddPermissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPermissionRequest) ProtoMessage() {}
+
+func (x *AddPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPermissionRequest.ProtoReflect.Descriptor instead.
+func (*AddPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *AddPermissionRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AddPermissionRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AddPermissionRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type RemovePermissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupName   string   `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Target      string   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *RemovePermissionRequest) Reset() {
+	*x = RemovePermissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_systera_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemovePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePermissionRequest) ProtoMessage() {}
+
+func (x *RemovePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systera_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePermissionRequest.ProtoReflect.Descriptor instead.
+func (*RemovePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_systera_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *RemovePermissionRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *RemovePermissionRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *RemovePermissionRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+var File_systera_proto protoreflect.FileDescriptor
+
+var file_systera_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x09, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x43, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x63, 0x6d, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
+	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x77, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22,
+	0x71, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x6d,
+	0x64, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
+	0x62, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x50, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x19, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x4a, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0x2e, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x6f, 0x74, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x22, 0x58, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x2d,
+	0x0a, 0x17, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x47, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x41, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x22, 0x87, 0x02, 0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x19, 0x0a,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5c, 0x0a, 0x22, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x5d, 0x0a, 0x23, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x4e, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0x60, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x22, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x79, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x06,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x39, 0x0a, 0x0b, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x5d, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x74,
+	0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x22, 0x60, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x7a, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0x63, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52,
+	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0xe5, 0x02, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
+	0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2b, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x04, 0x63,
+	0x68, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x06,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x7c, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52,
+	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4c, 0x0a,
+	0x0c, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x14, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x5f, 0x0a, 0x10, 0x41, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4e, 0x4e, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x22, 0x99,
+	0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22,
+	0x29, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x02, 0x22, 0xb8, 0x02, 0x0a, 0x10, 0x50,
+	0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50,
+	0x75, 0x6e, 0x69, 0x73, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x11, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x39,
+	0x0a, 0x0c, 0x61, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x2a, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x4e, 0x49, 0x53, 0x48, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x50, 0x50,
+	0x45, 0x41, 0x4c, 0x10, 0x02, 0x22, 0x6a, 0x0a, 0x11, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x22, 0x9a, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x22, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x01, 0x22, 0x88,
+	0x01, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22,
+	0x18, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x22, 0x83, 0x01, 0x0a, 0x0a, 0x43, 0x68,
+	0x61, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x10, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x48, 0x41, 0x54, 0x10, 0x00, 0x22,
+	0x38, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x70, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6a, 0x6f, 0x69, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6e, 0x69, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76, 0x61, 0x6e, 0x69, 0x73, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x22, 0xab, 0x02, 0x0a,
+	0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
+	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x22, 0x7d, 0x0a, 0x18, 0x49, 0x6e,
+	0x69, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb4, 0x02, 0x0a, 0x19, 0x49, 0x6e,
+	0x69, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6a,
+	0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x03,
+	0x62, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x03, 0x62, 0x61, 0x6e, 0x12, 0x35, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x62, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x6b, 0x69, 0x63, 0x6b, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x69, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x2f, 0x0a, 0x19, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x22, 0x35, 0x0a, 0x1f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x74, 0x0a, 0x1a, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
+	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x66, 0x66, 0x5f, 0x6e, 0x6f,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x73, 0x74, 0x61, 0x66, 0x66, 0x4e, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x44,
+	0x0a, 0x16, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x4d, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x75,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74,
+	0x74, 0x6c, 0x22, 0x3b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x6b, 0x0a, 0x11, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x4e, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50,
+	0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x60,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50,
+	0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x22, 0x81, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6c, 0x65, 0x66, 0x74, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x50, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x62, 0x0a,
+	0x0d, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x35,
+	0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x6c, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x54, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x82, 0x01, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73,
+	0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65,
+	0x6e, 0x22, 0x71, 0x0a, 0x0e, 0x41, 0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x22, 0x33, 0x0a, 0x10, 0x41, 0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x11, 0x41, 0x6c, 0x74,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4e,
+	0x6f, 0x74, 0x65, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
+	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x43, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x44, 0x0a, 0x15, 0x41,
+	0x64, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x22, 0x55, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0xdf, 0x02, 0x0a, 0x0b, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2c, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12,
+	0x3e, 0x0a, 0x0d, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x0c, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x3a, 0x0a, 0x0b, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x65,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
+	0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x39, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0b,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x22, 0x47, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73,
+	0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x74, 0x0a,
+	0x16, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0x8c, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f,
+	0x77, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f,
+	0x77, 0x6e, 0x22, 0x41, 0x0a, 0x0c, 0x55, 0x6e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x55, 0x6e, 0x42, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50,
+	0x75, 0x6e, 0x69, 0x73, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x56, 0x0a, 0x10, 0x50, 0x75,
+	0x6e, 0x69, 0x73, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75, 0x6e,
+	0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75,
+	0x6e, 0x69, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x22, 0xd9, 0x02, 0x0a, 0x0b, 0x41, 0x70, 0x70,
+	0x65, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x6f, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4e,
+	0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x22, 0x6c, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
+	0x62, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x47,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x35, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x74, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x70, 0x70, 0x65,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xf3, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xa4,
+	0x01, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x29, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x3d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x92, 0x02, 0x0a, 0x0d, 0x45, 0x76, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75,
+	0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x35,
+	0x0a, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x62, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x58, 0x0a,
+	0x12, 0x41, 0x64, 0x64, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x22, 0x45, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x45, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x28,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x6c, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x55, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x14,
+	0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x13, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x4c, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
+	0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x33, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x6f, 0x0a, 0x14, 0x41,
+	0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x72, 0x0a, 0x17,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2a, 0x38, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x55,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x56, 0x0a, 0x0b, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x59, 0x53,
+	0x54, 0x45, 0x4d, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x55, 0x4e, 0x49, 0x53, 0x48, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x02, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04,
+	0x43, 0x48, 0x41, 0x54, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52,
+	0x10, 0x05, 0x2a, 0x3d, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x54, 0x43, 0x48, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x10,
+	0x02, 0x2a, 0x48, 0x0a, 0x0b, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x49, 0x43, 0x4b, 0x10,
+	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x4d, 0x50, 0x42, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x45, 0x52, 0x4d, 0x42, 0x41, 0x4e, 0x10, 0x04, 0x2a, 0x56, 0x0a, 0x0b, 0x41,
+	0x70, 0x70, 0x65, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x50,
+	0x50, 0x45, 0x41, 0x4c, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x50,
+	0x50, 0x45, 0x41, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41,
+	0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x11, 0x0a, 0x0d, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45,
+	0x44, 0x10, 0x03, 0x32, 0xea, 0x23, 0x0a, 0x07, 0x53, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x12,
+	0x45, 0x0a, 0x08, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x1a, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x44,
+	0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a,
+	0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x12, 0x21, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x10, 0x55, 0x6e, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x1b, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7e,
+	0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x1b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a,
+	0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x41, 0x63, 0x6b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x41, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x43,
+	0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12,
+	0x22, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x29, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x49,
+	0x6e, 0x69, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x12, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x24, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6f, 0x0a, 0x18, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x46, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x4c, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a,
+	0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x23, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x1d, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x28, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d,
+	0x65, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x09, 0x41, 0x6c,
+	0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x1b, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x41, 0x6c, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x6f, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x41, 0x64, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62,
+	0x2e, 0x41, 0x64, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e,
+	0x69, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x12,
+	0x21, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x05, 0x55, 0x6e, 0x42, 0x61,
+	0x6e, 0x12, 0x17, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x6e,
+	0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x75,
+	0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x1e, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x70, 0x70, 0x65, 0x61, 0x6c, 0x12, 0x1e, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x70,
+	0x70, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1c, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b,
+	0x41, 0x64, 0x64, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x12,
+	0x21, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72,
+	0x61, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44,
+	0x0a, 0x0d, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x72, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x42, 0x37, 0x0a, 0x19, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x74, 0x68, 0x69,
+	0x61, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x42, 0x0d, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x5a, 0x0b, 0x2e, 0x2f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x72, 0x61, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_systera_proto_rawDescOnce sync.Once
+	file_systera_proto_rawDescData = file_systera_proto_rawDesc
+)
+
+func file_systera_proto_rawDescGZIP() []byte {
+	file_systera_proto_rawDescOnce.Do(func() {
+		file_systera_proto_rawDescData = protoimpl.X.CompressGZIP(file_systera_proto_rawDescData)
+	})
+	return file_systera_proto_rawDescData
+}
+
+var file_systera_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_systera_proto_msgTypes = make([]protoimpl.MessageInfo, 122)
+var file_systera_proto_goTypes = []interface{}{
+	(CallResult)(0),                             // 0: systerapb.CallResult
+	(StreamTopic)(0),                            // 1: systerapb.StreamTopic
+	(NoteSeverity)(0),                           // 2: systerapb.NoteSeverity
+	(PunishLevel)(0),                            // 3: systerapb.PunishLevel
+	(AppealState)(0),                            // 4: systerapb.AppealState
+	(SystemStream_Type)(0),                      // 5: systerapb.SystemStream.Type
+	(PlayerStream_Type)(0),                      // 6: systerapb.PlayerStream.Type
+	(PunishmentStream_Type)(0),                  // 7: systerapb.PunishmentStream.Type
+	(GroupStream_Type)(0),                       // 8: systerapb.GroupStream.Type
+	(ServerStream_Type)(0),                      // 9: systerapb.ServerStream.Type
+	(ChatStream_Type)(0),                        // 10: systerapb.ChatStream.Type
+	(*Empty)(nil),                               // 11: systerapb.Empty
+	(*AnnounceRequest)(nil),                     // 12: systerapb.AnnounceRequest
+	(*AnnounceResponse)(nil),                    // 13: systerapb.AnnounceResponse
+	(*DispatchRequest)(nil),                     // 14: systerapb.DispatchRequest
+	(*DispatchResult)(nil),                      // 15: systerapb.DispatchResult
+	(*DispatchResponse)(nil),                    // 16: systerapb.DispatchResponse
+	(*ReportDispatchResultRequest)(nil),         // 17: systerapb.ReportDispatchResultRequest
+	(*DispatchEntry)(nil),                       // 18: systerapb.DispatchEntry
+	(*GetDispatchHistoryRequest)(nil),           // 19: systerapb.GetDispatchHistoryRequest
+	(*GetDispatchHistoryResponse)(nil),          // 20: systerapb.GetDispatchHistoryResponse
+	(*ServerGroupEntry)(nil),                    // 21: systerapb.ServerGroupEntry
+	(*FetchServerGroupsRequest)(nil),            // 22: systerapb.FetchServerGroupsRequest
+	(*FetchServerGroupsResponse)(nil),           // 23: systerapb.FetchServerGroupsResponse
+	(*SetServerGroupRequest)(nil),               // 24: systerapb.SetServerGroupRequest
+	(*RemoveServerGroupRequest)(nil),            // 25: systerapb.RemoveServerGroupRequest
+	(*ServerEntry)(nil),                         // 26: systerapb.ServerEntry
+	(*ServerHeartbeatRequest)(nil),              // 27: systerapb.ServerHeartbeatRequest
+	(*UnregisterServerRequest)(nil),             // 28: systerapb.UnregisterServerRequest
+	(*ListServersRequest)(nil),                  // 29: systerapb.ListServersRequest
+	(*ListServersResponse)(nil),                 // 30: systerapb.ListServersResponse
+	(*GetServerRequest)(nil),                    // 31: systerapb.GetServerRequest
+	(*GetServerResponse)(nil),                   // 32: systerapb.GetServerResponse
+	(*ScheduledAnnouncement)(nil),               // 33: systerapb.ScheduledAnnouncement
+	(*CreateScheduledAnnouncementRequest)(nil),  // 34: systerapb.CreateScheduledAnnouncementRequest
+	(*CreateScheduledAnnouncementResponse)(nil), // 35: systerapb.CreateScheduledAnnouncementResponse
+	(*ListScheduledAnnouncementsRequest)(nil),   // 36: systerapb.ListScheduledAnnouncementsRequest
+	(*ListScheduledAnnouncementsResponse)(nil),  // 37: systerapb.ListScheduledAnnouncementsResponse
+	(*DeleteScheduledAnnouncementRequest)(nil),  // 38: systerapb.DeleteScheduledAnnouncementRequest
+	(*ChatEntry)(nil),                           // 39: systerapb.ChatEntry
+	(*ChatRequest)(nil),                         // 40: systerapb.ChatRequest
+	(*AddChatIgnoreRequest)(nil),                // 41: systerapb.AddChatIgnoreRequest
+	(*RemoveChatIgnoreRequest)(nil),             // 42: systerapb.RemoveChatIgnoreRequest
+	(*ChatIgnoreResponse)(nil),                  // 43: systerapb.ChatIgnoreResponse
+	(*SubscribeRequest)(nil),                    // 44: systerapb.SubscribeRequest
+	(*StreamEvent)(nil),                         // 45: systerapb.StreamEvent
+	(*ReplayEventsRequest)(nil),                 // 46: systerapb.ReplayEventsRequest
+	(*JournalEvent)(nil),                        // 47: systerapb.JournalEvent
+	(*ReplayEventsResponse)(nil),                // 48: systerapb.ReplayEventsResponse
+	(*AckEventsRequest)(nil),                    // 49: systerapb.AckEventsRequest
+	(*SystemStream)(nil),                        // 50: systerapb.SystemStream
+	(*PlayerStream)(nil),                        // 51: systerapb.PlayerStream
+	(*PunishmentStream)(nil),                    // 52: systerapb.PunishmentStream
+	(*PunishStreamEntry)(nil),                   // 53: systerapb.PunishStreamEntry
+	(*GroupStream)(nil),                         // 54: systerapb.GroupStream
+	(*ServerStream)(nil),                        // 55: systerapb.ServerStream
+	(*ChatStream)(nil),                          // 56: systerapb.ChatStream
+	(*PlayerIdentity)(nil),                      // 57: systerapb.PlayerIdentity
+	(*GetPlayerIdentityByNameRequest)(nil),      // 58: systerapb.GetPlayerIdentityByNameRequest
+	(*GetPlayerIdentityByNameResponse)(nil),     // 59: systerapb.GetPlayerIdentityByNameResponse
+	(*PlayerSettings)(nil),                      // 60: systerapb.PlayerSettings
+	(*PlayerEntry)(nil),                         // 61: systerapb.PlayerEntry
+	(*InitPlayerProfileRequest)(nil),            // 62: systerapb.InitPlayerProfileRequest
+	(*InitPlayerProfileResponse)(nil),           // 63: systerapb.InitPlayerProfileResponse
+	(*FetchPlayerProfileRequest)(nil),           // 64: systerapb.FetchPlayerProfileRequest
+	(*FetchPlayerProfileByNameRequest)(nil),     // 65: systerapb.FetchPlayerProfileByNameRequest
+	(*FetchPlayerProfileResponse)(nil),          // 66: systerapb.FetchPlayerProfileResponse
+	(*SetPlayerGroupsRequest)(nil),              // 67: systerapb.SetPlayerGroupsRequest
+	(*SetPlayerServerRequest)(nil),              // 68: systerapb.SetPlayerServerRequest
+	(*RemovePlayerServerRequest)(nil),           // 69: systerapb.RemovePlayerServerRequest
+	(*HeartbeatRequest)(nil),                    // 70: systerapb.HeartbeatRequest
+	(*ListOnlinePlayersRequest)(nil),            // 71: systerapb.ListOnlinePlayersRequest
+	(*OnlinePlayerEntry)(nil),                   // 72: systerapb.OnlinePlayerEntry
+	(*ListOnlinePlayersResponse)(nil),           // 73: systerapb.ListOnlinePlayersResponse
+	(*NameHistoryEntry)(nil),                    // 74: systerapb.NameHistoryEntry
+	(*GetNameHistoryRequest)(nil),               // 75: systerapb.GetNameHistoryRequest
+	(*GetNameHistoryResponse)(nil),              // 76: systerapb.GetNameHistoryResponse
+	(*ServerPlaytime)(nil),                      // 77: systerapb.ServerPlaytime
+	(*GetPlaytimeRequest)(nil),                  // 78: systerapb.GetPlaytimeRequest
+	(*GetPlaytimeResponse)(nil),                 // 79: systerapb.GetPlaytimeResponse
+	(*SessionEntry)(nil),                        // 80: systerapb.SessionEntry
+	(*GetSessionHistoryRequest)(nil),            // 81: systerapb.GetSessionHistoryRequest
+	(*GetSessionHistoryResponse)(nil),           // 82: systerapb.GetSessionHistoryResponse
+	(*PlaytimeEntry)(nil),                       // 83: systerapb.PlaytimeEntry
+	(*GetPlaytimeLeaderboardRequest)(nil),       // 84: systerapb.GetPlaytimeLeaderboardRequest
+	(*GetPlaytimeLeaderboardResponse)(nil),      // 85: systerapb.GetPlaytimeLeaderboardResponse
+	(*SetPlayerSettingsRequest)(nil),            // 86: systerapb.SetPlayerSettingsRequest
+	(*AddressesEntry)(nil),                      // 87: systerapb.AddressesEntry
+	(*AltLookupEntry)(nil),                      // 88: systerapb.AltLookupEntry
+	(*AltLookupRequest)(nil),                    // 89: systerapb.AltLookupRequest
+	(*AltLookupResponse)(nil),                   // 90: systerapb.AltLookupResponse
+	(*PlayerNote)(nil),                          // 91: systerapb.PlayerNote
+	(*AddPlayerNoteRequest)(nil),                // 92: systerapb.AddPlayerNoteRequest
+	(*AddPlayerNoteResponse)(nil),               // 93: systerapb.AddPlayerNoteResponse
+	(*ListPlayerNotesRequest)(nil),              // 94: systerapb.ListPlayerNotesRequest
+	(*ListPlayerNotesResponse)(nil),             // 95: systerapb.ListPlayerNotesResponse
+	(*DeletePlayerNoteRequest)(nil),             // 96: systerapb.DeletePlayerNoteRequest
+	(*PunishEntry)(nil),                         // 97: systerapb.PunishEntry
+	(*GetPlayerPunishRequest)(nil),              // 98: systerapb.GetPlayerPunishRequest
+	(*GetPlayerPunishResponse)(nil),             // 99: systerapb.GetPlayerPunishResponse
+	(*SetPlayerPunishRequest)(nil),              // 100: systerapb.SetPlayerPunishRequest
+	(*SetPlayerPunishResponse)(nil),             // 101: systerapb.SetPlayerPunishResponse
+	(*UnBanRequest)(nil),                        // 102: systerapb.UnBanRequest
+	(*UnBanResponse)(nil),                       // 103: systerapb.UnBanResponse
+	(*SearchPunishmentsRequest)(nil),            // 104: systerapb.SearchPunishmentsRequest
+	(*PunishLevelCount)(nil),                    // 105: systerapb.PunishLevelCount
+	(*SearchPunishmentsResponse)(nil),           // 106: systerapb.SearchPunishmentsResponse
+	(*AppealEntry)(nil),                         // 107: systerapb.AppealEntry
+	(*SubmitAppealRequest)(nil),                 // 108: systerapb.SubmitAppealRequest
+	(*SubmitAppealResponse)(nil),                // 109: systerapb.SubmitAppealResponse
+	(*ListAppealsRequest)(nil),                  // 110: systerapb.ListAppealsRequest
+	(*ListAppealsResponse)(nil),                 // 111: systerapb.ListAppealsResponse
+	(*ReviewAppealRequest)(nil),                 // 112: systerapb.ReviewAppealRequest
+	(*ReviewAppealResponse)(nil),                // 113: systerapb.ReviewAppealResponse
+	(*ReportEntry)(nil),                         // 114: systerapb.ReportEntry
+	(*ReportRequest)(nil),                       // 115: systerapb.ReportRequest
+	(*ReportResponse)(nil),                      // 116: systerapb.ReportResponse
+	(*GetReportsRequest)(nil),                   // 117: systerapb.GetReportsRequest
+	(*GetReportsResponse)(nil),                  // 118: systerapb.GetReportsResponse
+	(*EvidenceEntry)(nil),                       // 119: systerapb.EvidenceEntry
+	(*AddEvidenceRequest)(nil),                  // 120: systerapb.AddEvidenceRequest
+	(*AddEvidenceResponse)(nil),                 // 121: systerapb.AddEvidenceResponse
+	(*GetEvidenceBlobRequest)(nil),              // 122: systerapb.GetEvidenceBlobRequest
+	(*GetEvidenceBlobResponse)(nil),             // 123: systerapb.GetEvidenceBlobResponse
+	(*GroupEntry)(nil),                          // 124: systerapb.GroupEntry
+	(*PermissionsEntry)(nil),                    // 125: systerapb.PermissionsEntry
+	(*FetchGroupsRequest)(nil),                  // 126: systerapb.FetchGroupsRequest
+	(*FetchGroupsResponse)(nil),                 // 127: systerapb.FetchGroupsResponse
+	(*CreateGroupRequest)(nil),                  // 128: systerapb.CreateGroupRequest
+	(*RemoveGroupRequest)(nil),                  // 129: systerapb.RemoveGroupRequest
+	(*UpdateGroupRequest)(nil),                  // 130: systerapb.UpdateGroupRequest
+	(*AddPermissionRequest)(nil),                // 131: systerapb.AddPermissionRequest
+	(*RemovePermissionRequest)(nil),             // 132: systerapb.RemovePermissionRequest
+}
+var file_systera_proto_depIdxs = []int32{
+	57,  // 0: systerapb.DispatchRequest.from:type_name -> systerapb.PlayerIdentity
+	15,  // 1: systerapb.DispatchResponse.results:type_name -> systerapb.DispatchResult
+	57,  // 2: systerapb.DispatchEntry.from:type_name -> systerapb.PlayerIdentity
+	15,  // 3: systerapb.DispatchEntry.results:type_name -> systerapb.DispatchResult
+	18,  // 4: systerapb.GetDispatchHistoryResponse.entries:type_name -> systerapb.DispatchEntry
+	21,  // 5: systerapb.FetchServerGroupsResponse.groups:type_name -> systerapb.ServerGroupEntry
+	21,  // 6: systerapb.SetServerGroupRequest.group:type_name -> systerapb.ServerGroupEntry
+	26,  // 7: systerapb.ServerHeartbeatRequest.entry:type_name -> systerapb.ServerEntry
+	26,  // 8: systerapb.ListServersResponse.entries:type_name -> systerapb.ServerEntry
+	26,  // 9: systerapb.GetServerResponse.entry:type_name -> systerapb.ServerEntry
+	57,  // 10: systerapb.ScheduledAnnouncement.author:type_name -> systerapb.PlayerIdentity
+	33,  // 11: systerapb.CreateScheduledAnnouncementRequest.entry:type_name -> systerapb.ScheduledAnnouncement
+	33,  // 12: systerapb.CreateScheduledAnnouncementResponse.entry:type_name -> systerapb.ScheduledAnnouncement
+	33,  // 13: systerapb.ListScheduledAnnouncementsResponse.entries:type_name -> systerapb.ScheduledAnnouncement
+	57,  // 14: systerapb.ChatEntry.author:type_name -> systerapb.PlayerIdentity
+	39,  // 15: systerapb.ChatRequest.entry:type_name -> systerapb.ChatEntry
+	57,  // 16: systerapb.AddChatIgnoreRequest.target:type_name -> systerapb.PlayerIdentity
+	57,  // 17: systerapb.RemoveChatIgnoreRequest.target:type_name -> systerapb.PlayerIdentity
+	0,   // 18: systerapb.ChatIgnoreResponse.result:type_name -> systerapb.CallResult
+	57,  // 19: systerapb.ChatIgnoreResponse.identity:type_name -> systerapb.PlayerIdentity
+	1,   // 20: systerapb.SubscribeRequest.topics:type_name -> systerapb.StreamTopic
+	50,  // 21: systerapb.StreamEvent.system:type_name -> systerapb.SystemStream
+	51,  // 22: systerapb.StreamEvent.player:type_name -> systerapb.PlayerStream
+	52,  // 23: systerapb.StreamEvent.punishment:type_name -> systerapb.PunishmentStream
+	54,  // 24: systerapb.StreamEvent.group:type_name -> systerapb.GroupStream
+	56,  // 25: systerapb.StreamEvent.chat:type_name -> systerapb.ChatStream
+	55,  // 26: systerapb.StreamEvent.server:type_name -> systerapb.ServerStream
+	1,   // 27: systerapb.ReplayEventsRequest.topics:type_name -> systerapb.StreamTopic
+	45,  // 28: systerapb.JournalEvent.event:type_name -> systerapb.StreamEvent
+	47,  // 29: systerapb.ReplayEventsResponse.events:type_name -> systerapb.JournalEvent
+	5,   // 30: systerapb.SystemStream.type:type_name -> systerapb.SystemStream.Type
+	6,   // 31: systerapb.PlayerStream.type:type_name -> systerapb.PlayerStream.Type
+	61,  // 32: systerapb.PlayerStream.entry:type_name -> systerapb.PlayerEntry
+	7,   // 33: systerapb.PunishmentStream.type:type_name -> systerapb.PunishmentStream.Type
+	53,  // 34: systerapb.PunishmentStream.punish_stream_entry:type_name -> systerapb.PunishStreamEntry
+	114, // 35: systerapb.PunishmentStream.report_entry:type_name -> systerapb.ReportEntry
+	107, // 36: systerapb.PunishmentStream.appeal_entry:type_name -> systerapb.AppealEntry
+	97,  // 37: systerapb.PunishStreamEntry.entry:type_name -> systerapb.PunishEntry
+	8,   // 38: systerapb.GroupStream.type:type_name -> systerapb.GroupStream.Type
+	124, // 39: systerapb.GroupStream.group_entry:type_name -> systerapb.GroupEntry
+	9,   // 40: systerapb.ServerStream.type:type_name -> systerapb.ServerStream.Type
+	26,  // 41: systerapb.ServerStream.entry:type_name -> systerapb.ServerEntry
+	10,  // 42: systerapb.ChatStream.type:type_name -> systerapb.ChatStream.Type
+	39,  // 43: systerapb.ChatStream.chat_entry:type_name -> systerapb.ChatEntry
+	57,  // 44: systerapb.GetPlayerIdentityByNameResponse.identity:type_name -> systerapb.PlayerIdentity
+	60,  // 45: systerapb.PlayerEntry.settings:type_name -> systerapb.PlayerSettings
+	57,  // 46: systerapb.PlayerEntry.player_ignore:type_name -> systerapb.PlayerIdentity
+	61,  // 47: systerapb.InitPlayerProfileResponse.entry:type_name -> systerapb.PlayerEntry
+	3,   // 48: systerapb.InitPlayerProfileResponse.ban:type_name -> systerapb.PunishLevel
+	97,  // 49: systerapb.InitPlayerProfileResponse.active_ban:type_name -> systerapb.PunishEntry
+	61,  // 50: systerapb.FetchPlayerProfileResponse.entry:type_name -> systerapb.PlayerEntry
+	57,  // 51: systerapb.OnlinePlayerEntry.identity:type_name -> systerapb.PlayerIdentity
+	72,  // 52: systerapb.ListOnlinePlayersResponse.entries:type_name -> systerapb.OnlinePlayerEntry
+	74,  // 53: systerapb.GetNameHistoryResponse.entries:type_name -> systerapb.NameHistoryEntry
+	77,  // 54: systerapb.GetPlaytimeResponse.servers:type_name -> systerapb.ServerPlaytime
+	80,  // 55: systerapb.GetSessionHistoryResponse.sessions:type_name -> systerapb.SessionEntry
+	57,  // 56: systerapb.PlaytimeEntry.identity:type_name -> systerapb.PlayerIdentity
+	83,  // 57: systerapb.GetPlaytimeLeaderboardResponse.entries:type_name -> systerapb.PlaytimeEntry
+	60,  // 58: systerapb.SetPlayerSettingsRequest.settings:type_name -> systerapb.PlayerSettings
+	87,  // 59: systerapb.AltLookupEntry.addresses:type_name -> systerapb.AddressesEntry
+	88,  // 60: systerapb.AltLookupResponse.entries:type_name -> systerapb.AltLookupEntry
+	2,   // 61: systerapb.PlayerNote.severity:type_name -> systerapb.NoteSeverity
+	57,  // 62: systerapb.PlayerNote.author:type_name -> systerapb.PlayerIdentity
+	91,  // 63: systerapb.AddPlayerNoteRequest.entry:type_name -> systerapb.PlayerNote
+	91,  // 64: systerapb.AddPlayerNoteResponse.entry:type_name -> systerapb.PlayerNote
+	91,  // 65: systerapb.ListPlayerNotesResponse.entries:type_name -> systerapb.PlayerNote
+	3,   // 66: systerapb.PunishEntry.level:type_name -> systerapb.PunishLevel
+	57,  // 67: systerapb.PunishEntry.punished_from:type_name -> systerapb.PlayerIdentity
+	57,  // 68: systerapb.PunishEntry.punished_to:type_name -> systerapb.PlayerIdentity
+	119, // 69: systerapb.PunishEntry.evidence:type_name -> systerapb.EvidenceEntry
+	3,   // 70: systerapb.GetPlayerPunishRequest.filter_level:type_name -> systerapb.PunishLevel
+	97,  // 71: systerapb.GetPlayerPunishResponse.entry:type_name -> systerapb.PunishEntry
+	97,  // 72: systerapb.SetPlayerPunishRequest.entry:type_name -> systerapb.PunishEntry
+	57,  // 73: systerapb.UnBanRequest.target:type_name -> systerapb.PlayerIdentity
+	3,   // 74: systerapb.SearchPunishmentsRequest.level:type_name -> systerapb.PunishLevel
+	3,   // 75: systerapb.PunishLevelCount.level:type_name -> systerapb.PunishLevel
+	97,  // 76: systerapb.SearchPunishmentsResponse.entries:type_name -> systerapb.PunishEntry
+	105, // 77: systerapb.SearchPunishmentsResponse.totals:type_name -> systerapb.PunishLevelCount
+	4,   // 78: systerapb.AppealEntry.state:type_name -> systerapb.AppealState
+	97,  // 79: systerapb.AppealEntry.punishment:type_name -> systerapb.PunishEntry
+	57,  // 80: systerapb.AppealEntry.from:type_name -> systerapb.PlayerIdentity
+	57,  // 81: systerapb.AppealEntry.reviewer:type_name -> systerapb.PlayerIdentity
+	57,  // 82: systerapb.SubmitAppealRequest.from:type_name -> systerapb.PlayerIdentity
+	107, // 83: systerapb.SubmitAppealResponse.entry:type_name -> systerapb.AppealEntry
+	4,   // 84: systerapb.ListAppealsRequest.state:type_name -> systerapb.AppealState
+	107, // 85: systerapb.ListAppealsResponse.entries:type_name -> systerapb.AppealEntry
+	57,  // 86: systerapb.ReviewAppealRequest.reviewer:type_name -> systerapb.PlayerIdentity
+	107, // 87: systerapb.ReviewAppealResponse.entry:type_name -> systerapb.AppealEntry
+	57,  // 88: systerapb.ReportEntry.from:type_name -> systerapb.PlayerIdentity
+	57,  // 89: systerapb.ReportEntry.to:type_name -> systerapb.PlayerIdentity
+	119, // 90: systerapb.ReportEntry.evidence:type_name -> systerapb.EvidenceEntry
+	57,  // 91: systerapb.ReportRequest.from:type_name -> systerapb.PlayerIdentity
+	57,  // 92: systerapb.ReportRequest.to:type_name -> systerapb.PlayerIdentity
+	114, // 93: systerapb.ReportResponse.entry:type_name -> systerapb.ReportEntry
+	114, // 94: systerapb.GetReportsResponse.entry:type_name -> systerapb.ReportEntry
+	57,  // 95: systerapb.EvidenceEntry.uploader:type_name -> systerapb.PlayerIdentity
+	119, // 96: systerapb.AddEvidenceRequest.entry:type_name -> systerapb.EvidenceEntry
+	119, // 97: systerapb.AddEvidenceResponse.entry:type_name -> systerapb.EvidenceEntry
+	119, // 98: systerapb.GetEvidenceBlobResponse.entry:type_name -> systerapb.EvidenceEntry
+	125, // 99: systerapb.GroupEntry.permissions:type_name -> systerapb.PermissionsEntry
+	124, // 100: systerapb.FetchGroupsResponse.groups:type_name -> systerapb.GroupEntry
+	124, // 101: systerapb.CreateGroupRequest.group_entry:type_name -> systerapb.GroupEntry
+	124, // 102: systerapb.UpdateGroupRequest.group_entry:type_name -> systerapb.GroupEntry
+	12,  // 103: systerapb.Systera.Announce:input_type -> systerapb.AnnounceRequest
+	14,  // 104: systerapb.Systera.Dispatch:input_type -> systerapb.DispatchRequest
+	17,  // 105: systerapb.Systera.ReportDispatchResult:input_type -> systerapb.ReportDispatchResultRequest
+	19,  // 106: systerapb.Systera.GetDispatchHistory:input_type -> systerapb.GetDispatchHistoryRequest
+	22,  // 107: systerapb.Systera.FetchServerGroups:input_type -> systerapb.FetchServerGroupsRequest
+	24,  // 108: systerapb.Systera.SetServerGroup:input_type -> systerapb.SetServerGroupRequest
+	25,  // 109: systerapb.Systera.RemoveServerGroup:input_type -> systerapb.RemoveServerGroupRequest
+	27,  // 110: systerapb.Systera.ServerHeartbeat:input_type -> systerapb.ServerHeartbeatRequest
+	28,  // 111: systerapb.Systera.UnregisterServer:input_type -> systerapb.UnregisterServerRequest
+	29,  // 112: systerapb.Systera.ListServers:input_type -> systerapb.ListServersRequest
+	31,  // 113: systerapb.Systera.GetServer:input_type -> systerapb.GetServerRequest
+	34,  // 114: systerapb.Systera.CreateScheduledAnnouncement:input_type -> systerapb.CreateScheduledAnnouncementRequest
+	36,  // 115: systerapb.Systera.ListScheduledAnnouncements:input_type -> systerapb.ListScheduledAnnouncementsRequest
+	38,  // 116: systerapb.Systera.DeleteScheduledAnnouncement:input_type -> systerapb.DeleteScheduledAnnouncementRequest
+	44,  // 117: systerapb.Systera.Subscribe:input_type -> systerapb.SubscribeRequest
+	46,  // 118: systerapb.Systera.ReplayEvents:input_type -> systerapb.ReplayEventsRequest
+	49,  // 119: systerapb.Systera.AckEvents:input_type -> systerapb.AckEventsRequest
+	40,  // 120: systerapb.Systera.Chat:input_type -> systerapb.ChatRequest
+	41,  // 121: systerapb.Systera.AddChatIgnore:input_type -> systerapb.AddChatIgnoreRequest
+	42,  // 122: systerapb.Systera.RemoveChatIgnore:input_type -> systerapb.RemoveChatIgnoreRequest
+	58,  // 123: systerapb.Systera.GetPlayerIdentityByName:input_type -> systerapb.GetPlayerIdentityByNameRequest
+	62,  // 124: systerapb.Systera.InitPlayerProfile:input_type -> systerapb.InitPlayerProfileRequest
+	64,  // 125: systerapb.Systera.FetchPlayerProfile:input_type -> systerapb.FetchPlayerProfileRequest
+	65,  // 126: systerapb.Systera.FetchPlayerProfileByName:input_type -> systerapb.FetchPlayerProfileByNameRequest
+	67,  // 127: systerapb.Systera.SetPlayerGroups:input_type -> systerapb.SetPlayerGroupsRequest
+	68,  // 128: systerapb.Systera.SetPlayerServer:input_type -> systerapb.SetPlayerServerRequest
+	69,  // 129: systerapb.Systera.RemovePlayerServer:input_type -> systerapb.RemovePlayerServerRequest
+	70,  // 130: systerapb.Systera.Heartbeat:input_type -> systerapb.HeartbeatRequest
+	71,  // 131: systerapb.Systera.ListOnlinePlayers:input_type -> systerapb.ListOnlinePlayersRequest
+	75,  // 132: systerapb.Systera.GetNameHistory:input_type -> systerapb.GetNameHistoryRequest
+	78,  // 133: systerapb.Systera.GetPlaytime:input_type -> systerapb.GetPlaytimeRequest
+	81,  // 134: systerapb.Systera.GetSessionHistory:input_type -> systerapb.GetSessionHistoryRequest
+	84,  // 135: systerapb.Systera.GetPlaytimeLeaderboard:input_type -> systerapb.GetPlaytimeLeaderboardRequest
+	86,  // 136: systerapb.Systera.SetPlayerSettings:input_type -> systerapb.SetPlayerSettingsRequest
+	89,  // 137: systerapb.Systera.AltLookup:input_type -> systerapb.AltLookupRequest
+	92,  // 138: systerapb.Systera.AddPlayerNote:input_type -> systerapb.AddPlayerNoteRequest
+	94,  // 139: systerapb.Systera.ListPlayerNotes:input_type -> systerapb.ListPlayerNotesRequest
+	96,  // 140: systerapb.Systera.DeletePlayerNote:input_type -> systerapb.DeletePlayerNoteRequest
+	98,  // 141: systerapb.Systera.GetPlayerPunish:input_type -> systerapb.GetPlayerPunishRequest
+	100, // 142: systerapb.Systera.SetPlayerPunish:input_type -> systerapb.SetPlayerPunishRequest
+	102, // 143: systerapb.Systera.UnBan:input_type -> systerapb.UnBanRequest
+	104, // 144: systerapb.Systera.SearchPunishments:input_type -> systerapb.SearchPunishmentsRequest
+	108, // 145: systerapb.Systera.SubmitAppeal:input_type -> systerapb.SubmitAppealRequest
+	110, // 146: systerapb.Systera.ListAppeals:input_type -> systerapb.ListAppealsRequest
+	112, // 147: systerapb.Systera.ReviewAppeal:input_type -> systerapb.ReviewAppealRequest
+	115, // 148: systerapb.Systera.Report:input_type -> systerapb.ReportRequest
+	117, // 149: systerapb.Systera.GetReports:input_type -> systerapb.GetReportsRequest
+	120, // 150: systerapb.Systera.AddEvidence:input_type -> systerapb.AddEvidenceRequest
+	122, // 151: systerapb.Systera.GetEvidenceBlob:input_type -> systerapb.GetEvidenceBlobRequest
+	126, // 152: systerapb.Systera.FetchGroups:input_type -> systerapb.FetchGroupsRequest
+	128, // 153: systerapb.Systera.CreateGroup:input_type -> systerapb.CreateGroupRequest
+	129, // 154: systerapb.Systera.RemoveGroup:input_type -> systerapb.RemoveGroupRequest
+	130, // 155: systerapb.Systera.UpdateGroup:input_type -> systerapb.UpdateGroupRequest
+	131, // 156: systerapb.Systera.AddPermission:input_type -> systerapb.AddPermissionRequest
+	132, // 157: systerapb.Systera.RemovePermission:input_type -> systerapb.RemovePermissionRequest
+	13,  // 158: systerapb.Systera.Announce:output_type -> systerapb.AnnounceResponse
+	16,  // 159: systerapb.Systera.Dispatch:output_type -> systerapb.DispatchResponse
+	11,  // 160: systerapb.Systera.ReportDispatchResult:output_type -> systerapb.Empty
+	20,  // 161: systerapb.Systera.GetDispatchHistory:output_type -> systerapb.GetDispatchHistoryResponse
+	23,  // 162: systerapb.Systera.FetchServerGroups:output_type -> systerapb.FetchServerGroupsResponse
+	11,  // 163: systerapb.Systera.SetServerGroup:output_type -> systerapb.Empty
+	11,  // 164: systerapb.Systera.RemoveServerGroup:output_type -> systerapb.Empty
+	11,  // 165: systerapb.Systera.ServerHeartbeat:output_type -> systerapb.Empty
+	11,  // 166: systerapb.Systera.UnregisterServer:output_type -> systerapb.Empty
+	30,  // 167: systerapb.Systera.ListServers:output_type -> systerapb.ListServersResponse
+	32,  // 168: systerapb.Systera.GetServer:output_type -> systerapb.GetServerResponse
+	35,  // 169: systerapb.Systera.CreateScheduledAnnouncement:output_type -> systerapb.CreateScheduledAnnouncementResponse
+	37,  // 170: systerapb.Systera.ListScheduledAnnouncements:output_type -> systerapb.ListScheduledAnnouncementsResponse
+	11,  // 171: systerapb.Systera.DeleteScheduledAnnouncement:output_type -> systerapb.Empty
+	45,  // 172: systerapb.Systera.Subscribe:output_type -> systerapb.StreamEvent
+	48,  // 173: systerapb.Systera.ReplayEvents:output_type -> systerapb.ReplayEventsResponse
+	11,  // 174: systerapb.Systera.AckEvents:output_type -> systerapb.Empty
+	11,  // 175: systerapb.Systera.Chat:output_type -> systerapb.Empty
+	43,  // 176: systerapb.Systera.AddChatIgnore:output_type -> systerapb.ChatIgnoreResponse
+	43,  // 177: systerapb.Systera.RemoveChatIgnore:output_type -> systerapb.ChatIgnoreResponse
+	59,  // 178: systerapb.Systera.GetPlayerIdentityByName:output_type -> systerapb.GetPlayerIdentityByNameResponse
+	63,  // 179: systerapb.Systera.InitPlayerProfile:output_type -> systerapb.InitPlayerProfileResponse
+	66,  // 180: systerapb.Systera.FetchPlayerProfile:output_type -> systerapb.FetchPlayerProfileResponse
+	66,  // 181: systerapb.Systera.FetchPlayerProfileByName:output_type -> systerapb.FetchPlayerProfileResponse
+	11,  // 182: systerapb.Systera.SetPlayerGroups:output_type -> systerapb.Empty
+	11,  // 183: systerapb.Systera.SetPlayerServer:output_type -> systerapb.Empty
+	11,  // 184: systerapb.Systera.RemovePlayerServer:output_type -> systerapb.Empty
+	11,  // 185: systerapb.Systera.Heartbeat:output_type -> systerapb.Empty
+	73,  // 186: systerapb.Systera.ListOnlinePlayers:output_type -> systerapb.ListOnlinePlayersResponse
+	76,  // 187: systerapb.Systera.GetNameHistory:output_type -> systerapb.GetNameHistoryResponse
+	79,  // 188: systerapb.Systera.GetPlaytime:output_type -> systerapb.GetPlaytimeResponse
+	82,  // 189: systerapb.Systera.GetSessionHistory:output_type -> systerapb.GetSessionHistoryResponse
+	85,  // 190: systerapb.Systera.GetPlaytimeLeaderboard:output_type -> systerapb.GetPlaytimeLeaderboardResponse
+	11,  // 191: systerapb.Systera.SetPlayerSettings:output_type -> systerapb.Empty
+	90,  // 192: systerapb.Systera.AltLookup:output_type -> systerapb.AltLookupResponse
+	93,  // 193: systerapb.Systera.AddPlayerNote:output_type -> systerapb.AddPlayerNoteResponse
+	95,  // 194: systerapb.Systera.ListPlayerNotes:output_type -> systerapb.ListPlayerNotesResponse
+	11,  // 195: systerapb.Systera.DeletePlayerNote:output_type -> systerapb.Empty
+	99,  // 196: systerapb.Systera.GetPlayerPunish:output_type -> systerapb.GetPlayerPunishResponse
+	101, // 197: systerapb.Systera.SetPlayerPunish:output_type -> systerapb.SetPlayerPunishResponse
+	103, // 198: systerapb.Systera.UnBan:output_type -> systerapb.UnBanResponse
+	106, // 199: systerapb.Systera.SearchPunishments:output_type -> systerapb.SearchPunishmentsResponse
+	109, // 200: systerapb.Systera.SubmitAppeal:output_type -> systerapb.SubmitAppealResponse
+	111, // 201: systerapb.Systera.ListAppeals:output_type -> systerapb.ListAppealsResponse
+	113, // 202: systerapb.Systera.ReviewAppeal:output_type -> systerapb.ReviewAppealResponse
+	116, // 203: systerapb.Systera.Report:output_type -> systerapb.ReportResponse
+	118, // 204: systerapb.Systera.GetReports:output_type -> systerapb.GetReportsResponse
+	121, // 205: systerapb.Systera.AddEvidence:output_type -> systerapb.AddEvidenceResponse
+	123, // 206: systerapb.Systera.GetEvidenceBlob:output_type -> systerapb.GetEvidenceBlobResponse
+	127, // 207: systerapb.Systera.FetchGroups:output_type -> systerapb.FetchGroupsResponse
+	11,  // 208: systerapb.Systera.CreateGroup:output_type -> systerapb.Empty
+	11,  // 209: systerapb.Systera.RemoveGroup:output_type -> systerapb.Empty
+	11,  // 210: systerapb.Systera.UpdateGroup:output_type -> systerapb.Empty
+	11,  // 211: systerapb.Systera.AddPermission:output_type -> systerapb.Empty
+	11,  // 212: systerapb.Systera.RemovePermission:output_type -> systerapb.Empty
+	158, // [158:213] is the sub-list for method output_type
+	103, // [103:158] is the sub-list for method input_type
+	103, // [103:103] is the sub-list for extension type_name
+	103, // [103:103] is the sub-list for extension extendee
+	0,   // [0:103] is the sub-list for field type_name
+}
+
+func init() { file_systera_proto_init() }
+func file_systera_proto_init() {
+	if File_systera_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_systera_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnounceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnounceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportDispatchResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDispatchHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDispatchHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerGroupEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchServerGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchServerGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetServerGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveServerGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerHeartbeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnregisterServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledAnnouncement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScheduledAnnouncementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateScheduledAnnouncementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledAnnouncementsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledAnnouncementsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteScheduledAnnouncementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddChatIgnoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveChatIgnoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatIgnoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JournalEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PunishmentStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PunishStreamEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerIdentityByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerIdentityByNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitPlayerProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitPlayerProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchPlayerProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchPlayerProfileByNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchPlayerProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlayerGroupsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3906,8 +10102,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnnounceRequest); i {
+		file_systera_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlayerServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3918,8 +10114,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DispatchRequest); i {
+		file_systera_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePlayerServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3930,8 +10126,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatEntry); i {
+		file_systera_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3942,8 +10138,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatRequest); i {
+		file_systera_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOnlinePlayersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3954,8 +10150,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddChatIgnoreRequest); i {
+		file_systera_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OnlinePlayerEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3966,8 +10162,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveChatIgnoreRequest); i {
+		file_systera_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOnlinePlayersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3978,8 +10174,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatIgnoreResponse); i {
+		file_systera_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NameHistoryEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3990,8 +10186,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SystemStream); i {
+		file_systera_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNameHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4002,8 +10198,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerStream); i {
+		file_systera_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNameHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4014,8 +10210,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PunishmentStream); i {
+		file_systera_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerPlaytime); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4026,8 +10222,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PunishStreamEntry); i {
+		file_systera_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaytimeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4038,8 +10234,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupStream); i {
+		file_systera_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaytimeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4050,8 +10246,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatStream); i {
+		file_systera_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4062,8 +10258,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerIdentity); i {
+		file_systera_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4074,8 +10270,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlayerIdentityByNameRequest); i {
+		file_systera_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4086,8 +10282,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlayerIdentityByNameResponse); i {
+		file_systera_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaytimeEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4098,8 +10294,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerSettings); i {
+		file_systera_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaytimeLeaderboardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4110,8 +10306,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerEntry); i {
+		file_systera_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaytimeLeaderboardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4122,8 +10318,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitPlayerProfileRequest); i {
+		file_systera_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlayerSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4134,8 +10330,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitPlayerProfileResponse); i {
+		file_systera_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressesEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4146,8 +10342,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchPlayerProfileRequest); i {
+		file_systera_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AltLookupEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4158,8 +10354,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchPlayerProfileByNameRequest); i {
+		file_systera_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AltLookupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4170,8 +10366,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchPlayerProfileResponse); i {
+		file_systera_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AltLookupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4182,8 +10378,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPlayerGroupsRequest); i {
+		file_systera_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerNote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4194,8 +10390,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPlayerServerRequest); i {
+		file_systera_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPlayerNoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4206,8 +10402,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemovePlayerServerRequest); i {
+		file_systera_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPlayerNoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4218,8 +10414,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPlayerSettingsRequest); i {
+		file_systera_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlayerNotesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4230,8 +10426,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressesEntry); i {
+		file_systera_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlayerNotesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4242,8 +10438,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AltLookupEntry); i {
+		file_systera_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePlayerNoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4254,8 +10450,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AltLookupRequest); i {
+		file_systera_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PunishEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4266,8 +10462,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AltLookupResponse); i {
+		file_systera_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerPunishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4278,8 +10474,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PunishEntry); i {
+		file_systera_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerPunishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4290,8 +10486,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlayerPunishRequest); i {
+		file_systera_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlayerPunishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4302,8 +10498,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlayerPunishResponse); i {
+		file_systera_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlayerPunishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4314,8 +10510,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPlayerPunishRequest); i {
+		file_systera_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnBanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4326,8 +10522,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetPlayerPunishResponse); i {
+		file_systera_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnBanResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4338,8 +10534,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnBanRequest); i {
+		file_systera_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPunishmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4350,8 +10546,8 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnBanResponse); i {
+		file_systera_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PunishLevelCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4362,7 +10558,103 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPunishmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppealEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAppealRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitAppealResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAppealsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAppealsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewAppealRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewAppealResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportEntry); i {
 			case 0:
 				return &v.state
@@ -4374,7 +10666,7 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportRequest); i {
 			case 0:
 				return &v.state
@@ -4386,7 +10678,7 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportResponse); i {
 			case 0:
 				return &v.state
@@ -4398,7 +10690,91 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReportsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvidenceEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEvidenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEvidenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvidenceBlobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvidenceBlobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_systera_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GroupEntry); i {
 			case 0:
 				return &v.state
@@ -4410,7 +10786,7 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PermissionsEntry); i {
 			case 0:
 				return &v.state
@@ -4422,7 +10798,7 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FetchGroupsRequest); i {
 			case 0:
 				return &v.state
@@ -4434,7 +10810,7 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FetchGroupsResponse); i {
 			case 0:
 				return &v.state
@@ -4446,7 +10822,7 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateGroupRequest); i {
 			case 0:
 				return &v.state
@@ -4458,7 +10834,7 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveGroupRequest); i {
 			case 0:
 				return &v.state
@@ -4470,7 +10846,7 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateGroupRequest); i {
 			case 0:
 				return &v.state
@@ -4482,7 +10858,7 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddPermissionRequest); i {
 			case 0:
 				return &v.state
@@ -4494,7 +10870,7 @@ func file_systera_proto_init() {
 				return nil
 			}
 		}
-		file_systera_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_systera_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemovePermissionRequest); i {
 			case 0:
 				return &v.state
@@ -4507,13 +10883,21 @@ func file_systera_proto_init() {
 			}
 		}
 	}
+	file_systera_proto_msgTypes[34].OneofWrappers = []interface{}{
+		(*StreamEvent_System)(nil),
+		(*StreamEvent_Player)(nil),
+		(*StreamEvent_Punishment)(nil),
+		(*StreamEvent_Group)(nil),
+		(*StreamEvent_Chat)(nil),
+		(*StreamEvent_Server)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_systera_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   51,
+			NumEnums:      11,
+			NumMessages:   122,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,8 +22,27 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SysteraClient interface {
-	Announce(ctx context.Context, in *AnnounceRequest, opts ...grpc.CallOption) (*Empty, error)
-	Dispatch(ctx context.Context, in *DispatchRequest, opts ...grpc.CallOption) (*Empty, error)
+	Announce(ctx context.Context, in *AnnounceRequest, opts ...grpc.CallOption) (*AnnounceResponse, error)
+	Dispatch(ctx context.Context, in *DispatchRequest, opts ...grpc.CallOption) (*DispatchResponse, error)
+	ReportDispatchResult(ctx context.Context, in *ReportDispatchResultRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetDispatchHistory(ctx context.Context, in *GetDispatchHistoryRequest, opts ...grpc.CallOption) (*GetDispatchHistoryResponse, error)
+	// Server Group
+	FetchServerGroups(ctx context.Context, in *FetchServerGroupsRequest, opts ...grpc.CallOption) (*FetchServerGroupsResponse, error)
+	SetServerGroup(ctx context.Context, in *SetServerGroupRequest, opts ...grpc.CallOption) (*Empty, error)
+	RemoveServerGroup(ctx context.Context, in *RemoveServerGroupRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Server Registry
+	ServerHeartbeat(ctx context.Context, in *ServerHeartbeatRequest, opts ...grpc.CallOption) (*Empty, error)
+	UnregisterServer(ctx context.Context, in *UnregisterServerRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListServers(ctx context.Context, in *ListServersRequest, opts ...grpc.CallOption) (*ListServersResponse, error)
+	GetServer(ctx context.Context, in *GetServerRequest, opts ...grpc.CallOption) (*GetServerResponse, error)
+	// Scheduled Announcement
+	CreateScheduledAnnouncement(ctx context.Context, in *CreateScheduledAnnouncementRequest, opts ...grpc.CallOption) (*CreateScheduledAnnouncementResponse, error)
+	ListScheduledAnnouncements(ctx context.Context, in *ListScheduledAnnouncementsRequest, opts ...grpc.CallOption) (*ListScheduledAnnouncementsResponse, error)
+	DeleteScheduledAnnouncement(ctx context.Context, in *DeleteScheduledAnnouncementRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Stream
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Systera_SubscribeClient, error)
+	ReplayEvents(ctx context.Context, in *ReplayEventsRequest, opts ...grpc.CallOption) (*ReplayEventsResponse, error)
+	AckEvents(ctx context.Context, in *AckEventsRequest, opts ...grpc.CallOption) (*Empty, error)
 	// Chat
 	Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*Empty, error)
 	AddChatIgnore(ctx context.Context, in *AddChatIgnoreRequest, opts ...grpc.CallOption) (*ChatIgnoreResponse, error)
@@ -36,12 +55,31 @@ type SysteraClient interface {
 	SetPlayerGroups(ctx context.Context, in *SetPlayerGroupsRequest, opts ...grpc.CallOption) (*Empty, error)
 	SetPlayerServer(ctx context.Context, in *SetPlayerServerRequest, opts ...grpc.CallOption) (*Empty, error)
 	RemovePlayerServer(ctx context.Context, in *RemovePlayerServerRequest, opts ...grpc.CallOption) (*Empty, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListOnlinePlayers(ctx context.Context, in *ListOnlinePlayersRequest, opts ...grpc.CallOption) (*ListOnlinePlayersResponse, error)
+	GetNameHistory(ctx context.Context, in *GetNameHistoryRequest, opts ...grpc.CallOption) (*GetNameHistoryResponse, error)
+	GetPlaytime(ctx context.Context, in *GetPlaytimeRequest, opts ...grpc.CallOption) (*GetPlaytimeResponse, error)
+	GetSessionHistory(ctx context.Context, in *GetSessionHistoryRequest, opts ...grpc.CallOption) (*GetSessionHistoryResponse, error)
+	GetPlaytimeLeaderboard(ctx context.Context, in *GetPlaytimeLeaderboardRequest, opts ...grpc.CallOption) (*GetPlaytimeLeaderboardResponse, error)
 	SetPlayerSettings(ctx context.Context, in *SetPlayerSettingsRequest, opts ...grpc.CallOption) (*Empty, error)
 	AltLookup(ctx context.Context, in *AltLookupRequest, opts ...grpc.CallOption) (*AltLookupResponse, error)
+	// Staff Note (requires staff scope)
+	AddPlayerNote(ctx context.Context, in *AddPlayerNoteRequest, opts ...grpc.CallOption) (*AddPlayerNoteResponse, error)
+	ListPlayerNotes(ctx context.Context, in *ListPlayerNotesRequest, opts ...grpc.CallOption) (*ListPlayerNotesResponse, error)
+	DeletePlayerNote(ctx context.Context, in *DeletePlayerNoteRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetPlayerPunish(ctx context.Context, in *GetPlayerPunishRequest, opts ...grpc.CallOption) (*GetPlayerPunishResponse, error)
 	SetPlayerPunish(ctx context.Context, in *SetPlayerPunishRequest, opts ...grpc.CallOption) (*SetPlayerPunishResponse, error)
 	UnBan(ctx context.Context, in *UnBanRequest, opts ...grpc.CallOption) (*UnBanResponse, error)
+	SearchPunishments(ctx context.Context, in *SearchPunishmentsRequest, opts ...grpc.CallOption) (*SearchPunishmentsResponse, error)
+	// Punishment Appeal
+	SubmitAppeal(ctx context.Context, in *SubmitAppealRequest, opts ...grpc.CallOption) (*SubmitAppealResponse, error)
+	ListAppeals(ctx context.Context, in *ListAppealsRequest, opts ...grpc.CallOption) (*ListAppealsResponse, error)
+	ReviewAppeal(ctx context.Context, in *ReviewAppealRequest, opts ...grpc.CallOption) (*ReviewAppealResponse, error)
 	Report(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportResponse, error)
+	GetReports(ctx context.Context, in *GetReportsRequest, opts ...grpc.CallOption) (*GetReportsResponse, error)
+	// Evidence
+	AddEvidence(ctx context.Context, in *AddEvidenceRequest, opts ...grpc.CallOption) (*AddEvidenceResponse, error)
+	GetEvidenceBlob(ctx context.Context, in *GetEvidenceBlobRequest, opts ...grpc.CallOption) (*GetEvidenceBlobResponse, error)
 	FetchGroups(ctx context.Context, in *FetchGroupsRequest, opts ...grpc.CallOption) (*FetchGroupsResponse, error)
 	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*Empty, error)
 	RemoveGroup(ctx context.Context, in *RemoveGroupRequest, opts ...grpc.CallOption) (*Empty, error)
@@ -58,8 +96,8 @@ func NewSysteraClient(cc grpc.ClientConnInterface) SysteraClient {
 	return &systeraClient{cc}
 }
 
-func (c *systeraClient) Announce(ctx context.Context, in *AnnounceRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *systeraClient) Announce(ctx context.Context, in *AnnounceRequest, opts ...grpc.CallOption) (*AnnounceResponse, error) {
+	out := new(AnnounceResponse)
 	err := c.cc.Invoke(ctx, "/systerapb.Systera/Announce", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -67,8 +105,8 @@ func (c *systeraClient) Announce(ctx context.Context, in *AnnounceRequest, opts
 	return out, nil
 }
 
-func (c *systeraClient) Dispatch(ctx context.Context, in *DispatchRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *systeraClient) Dispatch(ctx context.Context, in *DispatchRequest, opts ...grpc.CallOption) (*DispatchResponse, error) {
+	out := new(DispatchResponse)
 	err := c.cc.Invoke(ctx, "/systerapb.Systera/Dispatch", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -76,6 +114,164 @@ func (c *systeraClient) Dispatch(ctx context.Context, in *DispatchRequest, opts
 	return out, nil
 }
 
+func (c *systeraClient) ReportDispatchResult(ctx context.Context, in *ReportDispatchResultRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/ReportDispatchResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) GetDispatchHistory(ctx context.Context, in *GetDispatchHistoryRequest, opts ...grpc.CallOption) (*GetDispatchHistoryResponse, error) {
+	out := new(GetDispatchHistoryResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/GetDispatchHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) FetchServerGroups(ctx context.Context, in *FetchServerGroupsRequest, opts ...grpc.CallOption) (*FetchServerGroupsResponse, error) {
+	out := new(FetchServerGroupsResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/FetchServerGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) SetServerGroup(ctx context.Context, in *SetServerGroupRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/SetServerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) RemoveServerGroup(ctx context.Context, in *RemoveServerGroupRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/RemoveServerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) ServerHeartbeat(ctx context.Context, in *ServerHeartbeatRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/ServerHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) UnregisterServer(ctx context.Context, in *UnregisterServerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/UnregisterServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) ListServers(ctx context.Context, in *ListServersRequest, opts ...grpc.CallOption) (*ListServersResponse, error) {
+	out := new(ListServersResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/ListServers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) GetServer(ctx context.Context, in *GetServerRequest, opts ...grpc.CallOption) (*GetServerResponse, error) {
+	out := new(GetServerResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/GetServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) CreateScheduledAnnouncement(ctx context.Context, in *CreateScheduledAnnouncementRequest, opts ...grpc.CallOption) (*CreateScheduledAnnouncementResponse, error) {
+	out := new(CreateScheduledAnnouncementResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/CreateScheduledAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) ListScheduledAnnouncements(ctx context.Context, in *ListScheduledAnnouncementsRequest, opts ...grpc.CallOption) (*ListScheduledAnnouncementsResponse, error) {
+	out := new(ListScheduledAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/ListScheduledAnnouncements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) DeleteScheduledAnnouncement(ctx context.Context, in *DeleteScheduledAnnouncementRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/DeleteScheduledAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Systera_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Systera_ServiceDesc.Streams[0], "/systerapb.Systera/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &systeraSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Systera_SubscribeClient interface {
+	Recv() (*StreamEvent, error)
+	grpc.ClientStream
+}
+
+type systeraSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *systeraSubscribeClient) Recv() (*StreamEvent, error) {
+	m := new(StreamEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *systeraClient) ReplayEvents(ctx context.Context, in *ReplayEventsRequest, opts ...grpc.CallOption) (*ReplayEventsResponse, error) {
+	out := new(ReplayEventsResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/ReplayEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) AckEvents(ctx context.Context, in *AckEventsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/AckEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *systeraClient) Chat(ctx context.Context, in *ChatRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/systerapb.Systera/Chat", in, out, opts...)
@@ -166,6 +362,60 @@ func (c *systeraClient) RemovePlayerServer(ctx context.Context, in *RemovePlayer
 	return out, nil
 }
 
+func (c *systeraClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) ListOnlinePlayers(ctx context.Context, in *ListOnlinePlayersRequest, opts ...grpc.CallOption) (*ListOnlinePlayersResponse, error) {
+	out := new(ListOnlinePlayersResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/ListOnlinePlayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) GetNameHistory(ctx context.Context, in *GetNameHistoryRequest, opts ...grpc.CallOption) (*GetNameHistoryResponse, error) {
+	out := new(GetNameHistoryResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/GetNameHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) GetPlaytime(ctx context.Context, in *GetPlaytimeRequest, opts ...grpc.CallOption) (*GetPlaytimeResponse, error) {
+	out := new(GetPlaytimeResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/GetPlaytime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) GetSessionHistory(ctx context.Context, in *GetSessionHistoryRequest, opts ...grpc.CallOption) (*GetSessionHistoryResponse, error) {
+	out := new(GetSessionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/GetSessionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) GetPlaytimeLeaderboard(ctx context.Context, in *GetPlaytimeLeaderboardRequest, opts ...grpc.CallOption) (*GetPlaytimeLeaderboardResponse, error) {
+	out := new(GetPlaytimeLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/GetPlaytimeLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *systeraClient) SetPlayerSettings(ctx context.Context, in *SetPlayerSettingsRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/systerapb.Systera/SetPlayerSettings", in, out, opts...)
@@ -184,6 +434,33 @@ func (c *systeraClient) AltLookup(ctx context.Context, in *AltLookupRequest, opt
 	return out, nil
 }
 
+func (c *systeraClient) AddPlayerNote(ctx context.Context, in *AddPlayerNoteRequest, opts ...grpc.CallOption) (*AddPlayerNoteResponse, error) {
+	out := new(AddPlayerNoteResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/AddPlayerNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) ListPlayerNotes(ctx context.Context, in *ListPlayerNotesRequest, opts ...grpc.CallOption) (*ListPlayerNotesResponse, error) {
+	out := new(ListPlayerNotesResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/ListPlayerNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) DeletePlayerNote(ctx context.Context, in *DeletePlayerNoteRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/DeletePlayerNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *systeraClient) GetPlayerPunish(ctx context.Context, in *GetPlayerPunishRequest, opts ...grpc.CallOption) (*GetPlayerPunishResponse, error) {
 	out := new(GetPlayerPunishResponse)
 	err := c.cc.Invoke(ctx, "/systerapb.Systera/GetPlayerPunish", in, out, opts...)
@@ -211,6 +488,42 @@ func (c *systeraClient) UnBan(ctx context.Context, in *UnBanRequest, opts ...grp
 	return out, nil
 }
 
+func (c *systeraClient) SearchPunishments(ctx context.Context, in *SearchPunishmentsRequest, opts ...grpc.CallOption) (*SearchPunishmentsResponse, error) {
+	out := new(SearchPunishmentsResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/SearchPunishments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) SubmitAppeal(ctx context.Context, in *SubmitAppealRequest, opts ...grpc.CallOption) (*SubmitAppealResponse, error) {
+	out := new(SubmitAppealResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/SubmitAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) ListAppeals(ctx context.Context, in *ListAppealsRequest, opts ...grpc.CallOption) (*ListAppealsResponse, error) {
+	out := new(ListAppealsResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/ListAppeals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) ReviewAppeal(ctx context.Context, in *ReviewAppealRequest, opts ...grpc.CallOption) (*ReviewAppealResponse, error) {
+	out := new(ReviewAppealResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/ReviewAppeal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *systeraClient) Report(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportResponse, error) {
 	out := new(ReportResponse)
 	err := c.cc.Invoke(ctx, "/systerapb.Systera/Report", in, out, opts...)
@@ -220,6 +533,33 @@ func (c *systeraClient) Report(ctx context.Context, in *ReportRequest, opts ...g
 	return out, nil
 }
 
+func (c *systeraClient) GetReports(ctx context.Context, in *GetReportsRequest, opts ...grpc.CallOption) (*GetReportsResponse, error) {
+	out := new(GetReportsResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/GetReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) AddEvidence(ctx context.Context, in *AddEvidenceRequest, opts ...grpc.CallOption) (*AddEvidenceResponse, error) {
+	out := new(AddEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/AddEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systeraClient) GetEvidenceBlob(ctx context.Context, in *GetEvidenceBlobRequest, opts ...grpc.CallOption) (*GetEvidenceBlobResponse, error) {
+	out := new(GetEvidenceBlobResponse)
+	err := c.cc.Invoke(ctx, "/systerapb.Systera/GetEvidenceBlob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *systeraClient) FetchGroups(ctx context.Context, in *FetchGroupsRequest, opts ...grpc.CallOption) (*FetchGroupsResponse, error) {
 	out := new(FetchGroupsResponse)
 	err := c.cc.Invoke(ctx, "/systerapb.Systera/FetchGroups", in, out, opts...)
@@ -278,8 +618,27 @@ func (c *systeraClient) RemovePermission(ctx context.Context, in *RemovePermissi
 // All implementations should embed UnimplementedSysteraServer
 // for forward compatibility
 type SysteraServer interface {
-	Announce(context.Context, *AnnounceRequest) (*Empty, error)
-	Dispatch(context.Context, *DispatchRequest) (*Empty, error)
+	Announce(context.Context, *AnnounceRequest) (*AnnounceResponse, error)
+	Dispatch(context.Context, *DispatchRequest) (*DispatchResponse, error)
+	ReportDispatchResult(context.Context, *ReportDispatchResultRequest) (*Empty, error)
+	GetDispatchHistory(context.Context, *GetDispatchHistoryRequest) (*GetDispatchHistoryResponse, error)
+	// Server Group
+	FetchServerGroups(context.Context, *FetchServerGroupsRequest) (*FetchServerGroupsResponse, error)
+	SetServerGroup(context.Context, *SetServerGroupRequest) (*Empty, error)
+	RemoveServerGroup(context.Context, *RemoveServerGroupRequest) (*Empty, error)
+	// Server Registry
+	ServerHeartbeat(context.Context, *ServerHeartbeatRequest) (*Empty, error)
+	UnregisterServer(context.Context, *UnregisterServerRequest) (*Empty, error)
+	ListServers(context.Context, *ListServersRequest) (*ListServersResponse, error)
+	GetServer(context.Context, *GetServerRequest) (*GetServerResponse, error)
+	// Scheduled Announcement
+	CreateScheduledAnnouncement(context.Context, *CreateScheduledAnnouncementRequest) (*CreateScheduledAnnouncementResponse, error)
+	ListScheduledAnnouncements(context.Context, *ListScheduledAnnouncementsRequest) (*ListScheduledAnnouncementsResponse, error)
+	DeleteScheduledAnnouncement(context.Context, *DeleteScheduledAnnouncementRequest) (*Empty, error)
+	// Stream
+	Subscribe(*SubscribeRequest, Systera_SubscribeServer) error
+	ReplayEvents(context.Context, *ReplayEventsRequest) (*ReplayEventsResponse, error)
+	AckEvents(context.Context, *AckEventsRequest) (*Empty, error)
 	// Chat
 	Chat(context.Context, *ChatRequest) (*Empty, error)
 	AddChatIgnore(context.Context, *AddChatIgnoreRequest) (*ChatIgnoreResponse, error)
@@ -292,12 +651,31 @@ type SysteraServer interface {
 	SetPlayerGroups(context.Context, *SetPlayerGroupsRequest) (*Empty, error)
 	SetPlayerServer(context.Context, *SetPlayerServerRequest) (*Empty, error)
 	RemovePlayerServer(context.Context, *RemovePlayerServerRequest) (*Empty, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*Empty, error)
+	ListOnlinePlayers(context.Context, *ListOnlinePlayersRequest) (*ListOnlinePlayersResponse, error)
+	GetNameHistory(context.Context, *GetNameHistoryRequest) (*GetNameHistoryResponse, error)
+	GetPlaytime(context.Context, *GetPlaytimeRequest) (*GetPlaytimeResponse, error)
+	GetSessionHistory(context.Context, *GetSessionHistoryRequest) (*GetSessionHistoryResponse, error)
+	GetPlaytimeLeaderboard(context.Context, *GetPlaytimeLeaderboardRequest) (*GetPlaytimeLeaderboardResponse, error)
 	SetPlayerSettings(context.Context, *SetPlayerSettingsRequest) (*Empty, error)
 	AltLookup(context.Context, *AltLookupRequest) (*AltLookupResponse, error)
+	// Staff Note (requires staff scope)
+	AddPlayerNote(context.Context, *AddPlayerNoteRequest) (*AddPlayerNoteResponse, error)
+	ListPlayerNotes(context.Context, *ListPlayerNotesRequest) (*ListPlayerNotesResponse, error)
+	DeletePlayerNote(context.Context, *DeletePlayerNoteRequest) (*Empty, error)
 	GetPlayerPunish(context.Context, *GetPlayerPunishRequest) (*GetPlayerPunishResponse, error)
 	SetPlayerPunish(context.Context, *SetPlayerPunishRequest) (*SetPlayerPunishResponse, error)
 	UnBan(context.Context, *UnBanRequest) (*UnBanResponse, error)
+	SearchPunishments(context.Context, *SearchPunishmentsRequest) (*SearchPunishmentsResponse, error)
+	// Punishment Appeal
+	SubmitAppeal(context.Context, *SubmitAppealRequest) (*SubmitAppealResponse, error)
+	ListAppeals(context.Context, *ListAppealsRequest) (*ListAppealsResponse, error)
+	ReviewAppeal(context.Context, *ReviewAppealRequest) (*ReviewAppealResponse, error)
 	Report(context.Context, *ReportRequest) (*ReportResponse, error)
+	GetReports(context.Context, *GetReportsRequest) (*GetReportsResponse, error)
+	// Evidence
+	AddEvidence(context.Context, *AddEvidenceRequest) (*AddEvidenceResponse, error)
+	GetEvidenceBlob(context.Context, *GetEvidenceBlobRequest) (*GetEvidenceBlobResponse, error)
 	FetchGroups(context.Context, *FetchGroupsRequest) (*FetchGroupsResponse, error)
 	CreateGroup(context.Context, *CreateGroupRequest) (*Empty, error)
 	RemoveGroup(context.Context, *RemoveGroupRequest) (*Empty, error)
@@ -310,12 +688,57 @@ type SysteraServer interface {
 type UnimplementedSysteraServer struct {
 }
 
-func (UnimplementedSysteraServer) Announce(context.Context, *AnnounceRequest) (*Empty, error) {
+func (UnimplementedSysteraServer) Announce(context.Context, *AnnounceRequest) (*AnnounceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Announce not implemented")
 }
-func (UnimplementedSysteraServer) Dispatch(context.Context, *DispatchRequest) (*Empty, error) {
+func (UnimplementedSysteraServer) Dispatch(context.Context, *DispatchRequest) (*DispatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Dispatch not implemented")
 }
+func (UnimplementedSysteraServer) ReportDispatchResult(context.Context, *ReportDispatchResultRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDispatchResult not implemented")
+}
+func (UnimplementedSysteraServer) GetDispatchHistory(context.Context, *GetDispatchHistoryRequest) (*GetDispatchHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDispatchHistory not implemented")
+}
+func (UnimplementedSysteraServer) FetchServerGroups(context.Context, *FetchServerGroupsRequest) (*FetchServerGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchServerGroups not implemented")
+}
+func (UnimplementedSysteraServer) SetServerGroup(context.Context, *SetServerGroupRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServerGroup not implemented")
+}
+func (UnimplementedSysteraServer) RemoveServerGroup(context.Context, *RemoveServerGroupRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveServerGroup not implemented")
+}
+func (UnimplementedSysteraServer) ServerHeartbeat(context.Context, *ServerHeartbeatRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerHeartbeat not implemented")
+}
+func (UnimplementedSysteraServer) UnregisterServer(context.Context, *UnregisterServerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterServer not implemented")
+}
+func (UnimplementedSysteraServer) ListServers(context.Context, *ListServersRequest) (*ListServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServers not implemented")
+}
+func (UnimplementedSysteraServer) GetServer(context.Context, *GetServerRequest) (*GetServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServer not implemented")
+}
+func (UnimplementedSysteraServer) CreateScheduledAnnouncement(context.Context, *CreateScheduledAnnouncementRequest) (*CreateScheduledAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledAnnouncement not implemented")
+}
+func (UnimplementedSysteraServer) ListScheduledAnnouncements(context.Context, *ListScheduledAnnouncementsRequest) (*ListScheduledAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledAnnouncements not implemented")
+}
+func (UnimplementedSysteraServer) DeleteScheduledAnnouncement(context.Context, *DeleteScheduledAnnouncementRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduledAnnouncement not implemented")
+}
+func (UnimplementedSysteraServer) Subscribe(*SubscribeRequest, Systera_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedSysteraServer) ReplayEvents(context.Context, *ReplayEventsRequest) (*ReplayEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayEvents not implemented")
+}
+func (UnimplementedSysteraServer) AckEvents(context.Context, *AckEventsRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckEvents not implemented")
+}
 func (UnimplementedSysteraServer) Chat(context.Context, *ChatRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Chat not implemented")
 }
@@ -346,12 +769,39 @@ func (UnimplementedSysteraServer) SetPlayerServer(context.Context, *SetPlayerSer
 func (UnimplementedSysteraServer) RemovePlayerServer(context.Context, *RemovePlayerServerRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemovePlayerServer not implemented")
 }
+func (UnimplementedSysteraServer) Heartbeat(context.Context, *HeartbeatRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedSysteraServer) ListOnlinePlayers(context.Context, *ListOnlinePlayersRequest) (*ListOnlinePlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOnlinePlayers not implemented")
+}
+func (UnimplementedSysteraServer) GetNameHistory(context.Context, *GetNameHistoryRequest) (*GetNameHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNameHistory not implemented")
+}
+func (UnimplementedSysteraServer) GetPlaytime(context.Context, *GetPlaytimeRequest) (*GetPlaytimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaytime not implemented")
+}
+func (UnimplementedSysteraServer) GetSessionHistory(context.Context, *GetSessionHistoryRequest) (*GetSessionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionHistory not implemented")
+}
+func (UnimplementedSysteraServer) GetPlaytimeLeaderboard(context.Context, *GetPlaytimeLeaderboardRequest) (*GetPlaytimeLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaytimeLeaderboard not implemented")
+}
 func (UnimplementedSysteraServer) SetPlayerSettings(context.Context, *SetPlayerSettingsRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPlayerSettings not implemented")
 }
 func (UnimplementedSysteraServer) AltLookup(context.Context, *AltLookupRequest) (*AltLookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AltLookup not implemented")
 }
+func (UnimplementedSysteraServer) AddPlayerNote(context.Context, *AddPlayerNoteRequest) (*AddPlayerNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlayerNote not implemented")
+}
+func (UnimplementedSysteraServer) ListPlayerNotes(context.Context, *ListPlayerNotesRequest) (*ListPlayerNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlayerNotes not implemented")
+}
+func (UnimplementedSysteraServer) DeletePlayerNote(context.Context, *DeletePlayerNoteRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlayerNote not implemented")
+}
 func (UnimplementedSysteraServer) GetPlayerPunish(context.Context, *GetPlayerPunishRequest) (*GetPlayerPunishResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerPunish not implemented")
 }
@@ -361,9 +811,30 @@ func (UnimplementedSysteraServer) SetPlayerPunish(context.Context, *SetPlayerPun
 func (UnimplementedSysteraServer) UnBan(context.Context, *UnBanRequest) (*UnBanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnBan not implemented")
 }
+func (UnimplementedSysteraServer) SearchPunishments(context.Context, *SearchPunishmentsRequest) (*SearchPunishmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPunishments not implemented")
+}
+func (UnimplementedSysteraServer) SubmitAppeal(context.Context, *SubmitAppealRequest) (*SubmitAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAppeal not implemented")
+}
+func (UnimplementedSysteraServer) ListAppeals(context.Context, *ListAppealsRequest) (*ListAppealsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppeals not implemented")
+}
+func (UnimplementedSysteraServer) ReviewAppeal(context.Context, *ReviewAppealRequest) (*ReviewAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewAppeal not implemented")
+}
 func (UnimplementedSysteraServer) Report(context.Context, *ReportRequest) (*ReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
 }
+func (UnimplementedSysteraServer) GetReports(context.Context, *GetReportsRequest) (*GetReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReports not implemented")
+}
+func (UnimplementedSysteraServer) AddEvidence(context.Context, *AddEvidenceRequest) (*AddEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEvidence not implemented")
+}
+func (UnimplementedSysteraServer) GetEvidenceBlob(context.Context, *GetEvidenceBlobRequest) (*GetEvidenceBlobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvidenceBlob not implemented")
+}
 func (UnimplementedSysteraServer) FetchGroups(context.Context, *FetchGroupsRequest) (*FetchGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchGroups not implemented")
 }
@@ -394,254 +865,689 @@ func RegisterSysteraServer(s grpc.ServiceRegistrar, srv SysteraServer) {
 	s.RegisterService(&Systera_ServiceDesc, srv)
 }
 
-func _Systera_Announce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AnnounceRequest)
+func _Systera_Announce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnounceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).Announce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/Announce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).Announce(ctx, req.(*AnnounceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_Dispatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DispatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).Dispatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/Dispatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).Dispatch(ctx, req.(*DispatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_ReportDispatchResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDispatchResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).ReportDispatchResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/ReportDispatchResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).ReportDispatchResult(ctx, req.(*ReportDispatchResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_GetDispatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDispatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).GetDispatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/GetDispatchHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).GetDispatchHistory(ctx, req.(*GetDispatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_FetchServerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchServerGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).FetchServerGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/FetchServerGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).FetchServerGroups(ctx, req.(*FetchServerGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_SetServerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetServerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).SetServerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/SetServerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).SetServerGroup(ctx, req.(*SetServerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_RemoveServerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveServerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).RemoveServerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/RemoveServerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).RemoveServerGroup(ctx, req.(*RemoveServerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_ServerHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).ServerHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/ServerHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).ServerHeartbeat(ctx, req.(*ServerHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_UnregisterServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).UnregisterServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/UnregisterServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).UnregisterServer(ctx, req.(*UnregisterServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_ListServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).ListServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/ListServers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).ListServers(ctx, req.(*ListServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_GetServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).GetServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/GetServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).GetServer(ctx, req.(*GetServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_CreateScheduledAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).CreateScheduledAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/CreateScheduledAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).CreateScheduledAnnouncement(ctx, req.(*CreateScheduledAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_ListScheduledAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).ListScheduledAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/ListScheduledAnnouncements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).ListScheduledAnnouncements(ctx, req.(*ListScheduledAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_DeleteScheduledAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).DeleteScheduledAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/DeleteScheduledAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).DeleteScheduledAnnouncement(ctx, req.(*DeleteScheduledAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysteraServer).Subscribe(m, &systeraSubscribeServer{stream})
+}
+
+type Systera_SubscribeServer interface {
+	Send(*StreamEvent) error
+	grpc.ServerStream
+}
+
+type systeraSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *systeraSubscribeServer) Send(m *StreamEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Systera_ReplayEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).ReplayEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/ReplayEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).ReplayEvents(ctx, req.(*ReplayEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_AckEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).AckEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/AckEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).AckEvents(ctx, req.(*AckEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_Chat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).Chat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/Chat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).Chat(ctx, req.(*ChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_AddChatIgnore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChatIgnoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).AddChatIgnore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/AddChatIgnore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).AddChatIgnore(ctx, req.(*AddChatIgnoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_RemoveChatIgnore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveChatIgnoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).RemoveChatIgnore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/RemoveChatIgnore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).RemoveChatIgnore(ctx, req.(*RemoveChatIgnoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_GetPlayerIdentityByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerIdentityByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).GetPlayerIdentityByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/GetPlayerIdentityByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).GetPlayerIdentityByName(ctx, req.(*GetPlayerIdentityByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_InitPlayerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitPlayerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).InitPlayerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/InitPlayerProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).InitPlayerProfile(ctx, req.(*InitPlayerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_FetchPlayerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchPlayerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).FetchPlayerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/FetchPlayerProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).FetchPlayerProfile(ctx, req.(*FetchPlayerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_FetchPlayerProfileByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchPlayerProfileByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).FetchPlayerProfileByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/FetchPlayerProfileByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).FetchPlayerProfileByName(ctx, req.(*FetchPlayerProfileByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_SetPlayerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerGroupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).Announce(ctx, in)
+		return srv.(SysteraServer).SetPlayerGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/Announce",
+		FullMethod: "/systerapb.Systera/SetPlayerGroups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).Announce(ctx, req.(*AnnounceRequest))
+		return srv.(SysteraServer).SetPlayerGroups(ctx, req.(*SetPlayerGroupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_Dispatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DispatchRequest)
+func _Systera_SetPlayerServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerServerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).Dispatch(ctx, in)
+		return srv.(SysteraServer).SetPlayerServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/Dispatch",
+		FullMethod: "/systerapb.Systera/SetPlayerServer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).Dispatch(ctx, req.(*DispatchRequest))
+		return srv.(SysteraServer).SetPlayerServer(ctx, req.(*SetPlayerServerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_Chat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChatRequest)
+func _Systera_RemovePlayerServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePlayerServerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).Chat(ctx, in)
+		return srv.(SysteraServer).RemovePlayerServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/Chat",
+		FullMethod: "/systerapb.Systera/RemovePlayerServer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).Chat(ctx, req.(*ChatRequest))
+		return srv.(SysteraServer).RemovePlayerServer(ctx, req.(*RemovePlayerServerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_AddChatIgnore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddChatIgnoreRequest)
+func _Systera_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).AddChatIgnore(ctx, in)
+		return srv.(SysteraServer).Heartbeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/AddChatIgnore",
+		FullMethod: "/systerapb.Systera/Heartbeat",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).AddChatIgnore(ctx, req.(*AddChatIgnoreRequest))
+		return srv.(SysteraServer).Heartbeat(ctx, req.(*HeartbeatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_RemoveChatIgnore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveChatIgnoreRequest)
+func _Systera_ListOnlinePlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnlinePlayersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).RemoveChatIgnore(ctx, in)
+		return srv.(SysteraServer).ListOnlinePlayers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/RemoveChatIgnore",
+		FullMethod: "/systerapb.Systera/ListOnlinePlayers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).RemoveChatIgnore(ctx, req.(*RemoveChatIgnoreRequest))
+		return srv.(SysteraServer).ListOnlinePlayers(ctx, req.(*ListOnlinePlayersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_GetPlayerIdentityByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPlayerIdentityByNameRequest)
+func _Systera_GetNameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNameHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).GetPlayerIdentityByName(ctx, in)
+		return srv.(SysteraServer).GetNameHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/GetPlayerIdentityByName",
+		FullMethod: "/systerapb.Systera/GetNameHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).GetPlayerIdentityByName(ctx, req.(*GetPlayerIdentityByNameRequest))
+		return srv.(SysteraServer).GetNameHistory(ctx, req.(*GetNameHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_InitPlayerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InitPlayerProfileRequest)
+func _Systera_GetPlaytime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaytimeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).InitPlayerProfile(ctx, in)
+		return srv.(SysteraServer).GetPlaytime(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/InitPlayerProfile",
+		FullMethod: "/systerapb.Systera/GetPlaytime",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).InitPlayerProfile(ctx, req.(*InitPlayerProfileRequest))
+		return srv.(SysteraServer).GetPlaytime(ctx, req.(*GetPlaytimeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_FetchPlayerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FetchPlayerProfileRequest)
+func _Systera_GetSessionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).FetchPlayerProfile(ctx, in)
+		return srv.(SysteraServer).GetSessionHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/FetchPlayerProfile",
+		FullMethod: "/systerapb.Systera/GetSessionHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).FetchPlayerProfile(ctx, req.(*FetchPlayerProfileRequest))
+		return srv.(SysteraServer).GetSessionHistory(ctx, req.(*GetSessionHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_FetchPlayerProfileByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FetchPlayerProfileByNameRequest)
+func _Systera_GetPlaytimeLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaytimeLeaderboardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).FetchPlayerProfileByName(ctx, in)
+		return srv.(SysteraServer).GetPlaytimeLeaderboard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/FetchPlayerProfileByName",
+		FullMethod: "/systerapb.Systera/GetPlaytimeLeaderboard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).FetchPlayerProfileByName(ctx, req.(*FetchPlayerProfileByNameRequest))
+		return srv.(SysteraServer).GetPlaytimeLeaderboard(ctx, req.(*GetPlaytimeLeaderboardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_SetPlayerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPlayerGroupsRequest)
+func _Systera_SetPlayerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).SetPlayerGroups(ctx, in)
+		return srv.(SysteraServer).SetPlayerSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/SetPlayerGroups",
+		FullMethod: "/systerapb.Systera/SetPlayerSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).SetPlayerGroups(ctx, req.(*SetPlayerGroupsRequest))
+		return srv.(SysteraServer).SetPlayerSettings(ctx, req.(*SetPlayerSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_SetPlayerServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPlayerServerRequest)
+func _Systera_AltLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AltLookupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).SetPlayerServer(ctx, in)
+		return srv.(SysteraServer).AltLookup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/SetPlayerServer",
+		FullMethod: "/systerapb.Systera/AltLookup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).SetPlayerServer(ctx, req.(*SetPlayerServerRequest))
+		return srv.(SysteraServer).AltLookup(ctx, req.(*AltLookupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_RemovePlayerServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemovePlayerServerRequest)
+func _Systera_AddPlayerNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlayerNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).RemovePlayerServer(ctx, in)
+		return srv.(SysteraServer).AddPlayerNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/RemovePlayerServer",
+		FullMethod: "/systerapb.Systera/AddPlayerNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).RemovePlayerServer(ctx, req.(*RemovePlayerServerRequest))
+		return srv.(SysteraServer).AddPlayerNote(ctx, req.(*AddPlayerNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_SetPlayerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPlayerSettingsRequest)
+func _Systera_ListPlayerNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlayerNotesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).SetPlayerSettings(ctx, in)
+		return srv.(SysteraServer).ListPlayerNotes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/SetPlayerSettings",
+		FullMethod: "/systerapb.Systera/ListPlayerNotes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).SetPlayerSettings(ctx, req.(*SetPlayerSettingsRequest))
+		return srv.(SysteraServer).ListPlayerNotes(ctx, req.(*ListPlayerNotesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Systera_AltLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AltLookupRequest)
+func _Systera_DeletePlayerNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlayerNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysteraServer).AltLookup(ctx, in)
+		return srv.(SysteraServer).DeletePlayerNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/systerapb.Systera/AltLookup",
+		FullMethod: "/systerapb.Systera/DeletePlayerNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysteraServer).AltLookup(ctx, req.(*AltLookupRequest))
+		return srv.(SysteraServer).DeletePlayerNote(ctx, req.(*DeletePlayerNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -700,6 +1606,78 @@ func _Systera_UnBan_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Systera_SearchPunishments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPunishmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).SearchPunishments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/SearchPunishments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).SearchPunishments(ctx, req.(*SearchPunishmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_SubmitAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).SubmitAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/SubmitAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).SubmitAppeal(ctx, req.(*SubmitAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_ListAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).ListAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/ListAppeals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).ListAppeals(ctx, req.(*ListAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_ReviewAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).ReviewAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/ReviewAppeal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).ReviewAppeal(ctx, req.(*ReviewAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Systera_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReportRequest)
 	if err := dec(in); err != nil {
@@ -718,6 +1696,60 @@ func _Systera_Report_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Systera_GetReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).GetReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/GetReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).GetReports(ctx, req.(*GetReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_AddEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).AddEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/AddEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).AddEvidence(ctx, req.(*AddEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Systera_GetEvidenceBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEvidenceBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysteraServer).GetEvidenceBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/systerapb.Systera/GetEvidenceBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysteraServer).GetEvidenceBlob(ctx, req.(*GetEvidenceBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Systera_FetchGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FetchGroupsRequest)
 	if err := dec(in); err != nil {
@@ -841,6 +1873,62 @@ var Systera_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Dispatch",
 			Handler:    _Systera_Dispatch_Handler,
 		},
+		{
+			MethodName: "ReportDispatchResult",
+			Handler:    _Systera_ReportDispatchResult_Handler,
+		},
+		{
+			MethodName: "GetDispatchHistory",
+			Handler:    _Systera_GetDispatchHistory_Handler,
+		},
+		{
+			MethodName: "FetchServerGroups",
+			Handler:    _Systera_FetchServerGroups_Handler,
+		},
+		{
+			MethodName: "SetServerGroup",
+			Handler:    _Systera_SetServerGroup_Handler,
+		},
+		{
+			MethodName: "RemoveServerGroup",
+			Handler:    _Systera_RemoveServerGroup_Handler,
+		},
+		{
+			MethodName: "ServerHeartbeat",
+			Handler:    _Systera_ServerHeartbeat_Handler,
+		},
+		{
+			MethodName: "UnregisterServer",
+			Handler:    _Systera_UnregisterServer_Handler,
+		},
+		{
+			MethodName: "ListServers",
+			Handler:    _Systera_ListServers_Handler,
+		},
+		{
+			MethodName: "GetServer",
+			Handler:    _Systera_GetServer_Handler,
+		},
+		{
+			MethodName: "CreateScheduledAnnouncement",
+			Handler:    _Systera_CreateScheduledAnnouncement_Handler,
+		},
+		{
+			MethodName: "ListScheduledAnnouncements",
+			Handler:    _Systera_ListScheduledAnnouncements_Handler,
+		},
+		{
+			MethodName: "DeleteScheduledAnnouncement",
+			Handler:    _Systera_DeleteScheduledAnnouncement_Handler,
+		},
+		{
+			MethodName: "ReplayEvents",
+			Handler:    _Systera_ReplayEvents_Handler,
+		},
+		{
+			MethodName: "AckEvents",
+			Handler:    _Systera_AckEvents_Handler,
+		},
 		{
 			MethodName: "Chat",
 			Handler:    _Systera_Chat_Handler,
@@ -881,6 +1969,30 @@ var Systera_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemovePlayerServer",
 			Handler:    _Systera_RemovePlayerServer_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Systera_Heartbeat_Handler,
+		},
+		{
+			MethodName: "ListOnlinePlayers",
+			Handler:    _Systera_ListOnlinePlayers_Handler,
+		},
+		{
+			MethodName: "GetNameHistory",
+			Handler:    _Systera_GetNameHistory_Handler,
+		},
+		{
+			MethodName: "GetPlaytime",
+			Handler:    _Systera_GetPlaytime_Handler,
+		},
+		{
+			MethodName: "GetSessionHistory",
+			Handler:    _Systera_GetSessionHistory_Handler,
+		},
+		{
+			MethodName: "GetPlaytimeLeaderboard",
+			Handler:    _Systera_GetPlaytimeLeaderboard_Handler,
+		},
 		{
 			MethodName: "SetPlayerSettings",
 			Handler:    _Systera_SetPlayerSettings_Handler,
@@ -889,6 +2001,18 @@ var Systera_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AltLookup",
 			Handler:    _Systera_AltLookup_Handler,
 		},
+		{
+			MethodName: "AddPlayerNote",
+			Handler:    _Systera_AddPlayerNote_Handler,
+		},
+		{
+			MethodName: "ListPlayerNotes",
+			Handler:    _Systera_ListPlayerNotes_Handler,
+		},
+		{
+			MethodName: "DeletePlayerNote",
+			Handler:    _Systera_DeletePlayerNote_Handler,
+		},
 		{
 			MethodName: "GetPlayerPunish",
 			Handler:    _Systera_GetPlayerPunish_Handler,
@@ -901,10 +2025,38 @@ var Systera_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnBan",
 			Handler:    _Systera_UnBan_Handler,
 		},
+		{
+			MethodName: "SearchPunishments",
+			Handler:    _Systera_SearchPunishments_Handler,
+		},
+		{
+			MethodName: "SubmitAppeal",
+			Handler:    _Systera_SubmitAppeal_Handler,
+		},
+		{
+			MethodName: "ListAppeals",
+			Handler:    _Systera_ListAppeals_Handler,
+		},
+		{
+			MethodName: "ReviewAppeal",
+			Handler:    _Systera_ReviewAppeal_Handler,
+		},
 		{
 			MethodName: "Report",
 			Handler:    _Systera_Report_Handler,
 		},
+		{
+			MethodName: "GetReports",
+			Handler:    _Systera_GetReports_Handler,
+		},
+		{
+			MethodName: "AddEvidence",
+			Handler:    _Systera_AddEvidence_Handler,
+		},
+		{
+			MethodName: "GetEvidenceBlob",
+			Handler:    _Systera_GetEvidenceBlob_Handler,
+		},
 		{
 			MethodName: "FetchGroups",
 			Handler:    _Systera_FetchGroups_Handler,
@@ -930,6 +2082,12 @@ var Systera_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Systera_RemovePermission_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Systera_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "systera.proto",
 }